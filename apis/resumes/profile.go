@@ -18,6 +18,7 @@ package resumes
 
 import (
 	v1alpha1resumes "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	v1beta1resumes "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
 	//+kubebuilder:scaffold:operator-builder:imports
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -27,6 +28,7 @@ import (
 func ProfileGroupVersions() []schema.GroupVersion {
 	return []schema.GroupVersion{
 		v1alpha1resumes.GroupVersion,
+		v1beta1resumes.GroupVersion,
 		//+kubebuilder:scaffold:operator-builder:groupversions
 	}
 }
@@ -17,16 +17,16 @@ limitations under the License.
 package resumes
 
 import (
-	v1alpha1resumes "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
-	v1alpha1profile "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/resume"
+	v1beta1resumes "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 )
 
 // Code generated by operator-builder. DO NOT EDIT.
 
 // ProfileLatestGroupVersion returns the latest group version object associated with this
 // particular kind.
-var ProfileLatestGroupVersion = v1alpha1resumes.GroupVersion
+var ProfileLatestGroupVersion = v1beta1resumes.GroupVersion
 
 // ProfileLatestSample returns the latest sample manifest associated with this
 // particular kind.
-var ProfileLatestSample = v1alpha1profile.Sample(false)
+var ProfileLatestSample = v1beta1profile.Sample(false)
@@ -21,22 +21,64 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
 )
 
+// ConfigMapName returns the name of the ConfigMap which holds the rendered data for a
+// Certification.  Each Certification renders into its own ConfigMap so that one member
+// of the collection never overwrites the data of another.
+func ConfigMapName(parent *resumesv1alpha1.Certification) string {
+	return "resume-cert-" + parent.Name
+}
+
+// certificationData is the data file of a Certification for the hugo renderer.
+type certificationData struct {
+	Title             string `json:"title"`
+	Issuer            string `json:"issuer"`
+	EarnedDate        string `json:"earnedDate"`
+	EarnedDateDisplay string `json:"earnedDateDisplay"`
+	Alias             string `json:"alias"`
+	ValidationURL     string `json:"validationURL"`
+	ImageURL          string `json:"imageURL"`
+}
+
+// Data returns the rendered data file for a Certification.
+func Data(parent *resumesv1alpha1.Certification) (string, error) {
+	data, err := yaml.Marshal(certificationData{
+		Title:             parent.Spec.Title,
+		Issuer:            parent.Spec.Issuer,
+		EarnedDate:        parent.Spec.EarnedDate.Normalized(),
+		EarnedDateDisplay: parent.Spec.EarnedDate.Display(),
+		Alias:             parent.Spec.Alias,
+		ValidationURL:     parent.Spec.ValidationURL,
+		ImageURL:          parent.Spec.ImageURL,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to scaffold certification yaml for ConfigMap, %w", err)
+	}
+
+	return string(data), nil
+}
+
 // CreateConfigMapResumeCert creates the resume-cert ConfigMap resource.
 func CreateConfigMapResumeCert(
 	parent *resumesv1alpha1.Certification,
 	collection *resumesv1alpha1.Profile,
 ) ([]client.Object, error) {
+	data, err := Data(parent)
+	if err != nil {
+		return nil, err
+	}
+
 	resourceObjs := []client.Object{}
 	resourceObj := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "v1",
 			"kind":       "ConfigMap",
 			"metadata": map[string]interface{}{
-				"name": "resume-cert",
+				"name": ConfigMapName(parent),
 				"labels": map[string]interface{}{
 					"app.kubernetes.io/name":      "hugo",
 					"app.kubernetes.io/component": "data",
@@ -57,14 +99,7 @@ func CreateConfigMapResumeCert(
 				// controlled by field: alias
 				// controlled by field: validationURL
 				// controlled by field: imageURL
-				fmt.Sprintf("%s.yaml", parent.Spec.Alias): `
----
-title: ` + parent.Spec.Title + `
-issuer: ` + parent.Spec.Issuer + `
-earnedDate: ` + parent.Spec.EarnedDate + `
-alias: ` + parent.Spec.Alias + `
-validationURL: ` + parent.Spec.ValidationURL + `
-imageURL: ` + parent.Spec.ImageURL + ``,
+				fmt.Sprintf("%s.yaml", parent.Spec.Alias): data,
 			},
 		},
 	}
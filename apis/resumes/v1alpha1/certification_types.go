@@ -21,6 +21,7 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -47,7 +48,7 @@ type CertificationSpec struct {
 
 	Issuer string `json:"issuer,omitempty"`
 
-	EarnedDate string `json:"earnedDate,omitempty"`
+	EarnedDate Date `json:"earnedDate,omitempty"`
 
 	Alias string `json:"alias,omitempty"`
 
@@ -83,6 +84,12 @@ type CertificationStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Whether the Profile collection which the Certification belongs to is found.
+	CollectionConditions []metav1.Condition `json:"collectionConditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -146,6 +153,11 @@ func (component *Certification) SetPhaseCondition(condition *status.PhaseConditi
 	component.Status.Conditions = append(component.Status.Conditions, condition)
 }
 
+// SetCollectionCondition sets the condition of the collection of a component.
+func (component *Certification) SetCollectionCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&component.Status.CollectionConditions, condition)
+}
+
 // GetResources returns the child resource status for a component.
 func (component *Certification) GetChildResourceConditions() []*status.ChildResource {
 	return component.Status.Resources
@@ -0,0 +1,142 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var certificationlog = logf.Log.WithName("certification-resource")
+
+// certificationReader reads the other Certifications of a collection when validating the
+// alias of a Certification.  It reads from the API server rather than the cache so that
+// two Certifications created together cannot both claim the same alias.
+var certificationReader client.Reader
+
+func (r *Certification) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	certificationReader = mgr.GetAPIReader()
+
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-resumes-jefedavis-dev-v1alpha1-certification,mutating=false,failurePolicy=fail,sideEffects=None,groups=resumes.jefedavis.dev,resources=certifications,verbs=create;update,versions=v1alpha1,name=vcertification.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Certification{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *Certification) ValidateCreate() error {
+	certificationlog.Info("validate create", "name", r.Name)
+
+	return r.validateCertification()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *Certification) ValidateUpdate(old runtime.Object) error {
+	certificationlog.Info("validate update", "name", r.Name)
+
+	return r.validateCertification()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *Certification) ValidateDelete() error {
+	return nil
+}
+
+// validateCertification checks the earned date and the alias of a Certification.  The alias
+// names the data file of the Certification within the resume, so it must be set and must be
+// unique among the Certifications of the same collection.
+func (r *Certification) validateCertification() error {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if err := r.Spec.EarnedDate.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("earnedDate"), r.Spec.EarnedDate, err.Error()))
+	}
+
+	if r.Spec.Alias == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("alias"), "alias names the certification within the resume"))
+	} else {
+		duplicate, err := r.findDuplicateAlias()
+		if err != nil {
+			return apierrs.NewInternalError(err)
+		}
+
+		if duplicate != nil {
+			allErrs = append(allErrs, field.Duplicate(specPath.Child("alias"),
+				fmt.Sprintf("%s (already used by %s/%s)", r.Spec.Alias, duplicate.Namespace, duplicate.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrs.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "Certification"}, r.Name, allErrs)
+}
+
+// findDuplicateAlias returns another Certification of the same collection which uses the
+// same alias, or nil if there is none.  The collections are compared once they are resolved,
+// so that a Certification which references the only collection by name and one which
+// references no collection share it.
+func (r *Certification) findDuplicateAlias() (*Certification, error) {
+	if certificationReader == nil {
+		return nil, nil
+	}
+
+	var certificationList CertificationList
+
+	if err := certificationReader.List(context.TODO(), &certificationList); err != nil {
+		return nil, fmt.Errorf("unable to list Certifications, %w", err)
+	}
+
+	resolver := &collectionResolver{reader: certificationReader}
+
+	for i := range certificationList.Items {
+		other := &certificationList.Items[i]
+
+		if other.Namespace == r.Namespace && other.Name == r.Name || other.Spec.Alias != r.Spec.Alias {
+			continue
+		}
+
+		same, err := resolver.sameCollection(context.TODO(),
+			r.Spec.Collection.Name, r.Spec.Collection.Namespace,
+			other.Spec.Collection.Name, other.Spec.Collection.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		if same {
+			return other, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ConditionCollectionFound is the type of the condition of a member of a Profile collection
+// which is false while the Profile that the member references does not exist.
+const ConditionCollectionFound = "CollectionFound"
+
+// Reasons of the condition of a member of a Profile collection.
+const (
+	ReasonCollectionFound    = "CollectionFound"
+	ReasonCollectionNotFound = "CollectionNotFound"
+	ReasonCollectionAdopted  = "Adopted"
+)
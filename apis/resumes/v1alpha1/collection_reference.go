@@ -0,0 +1,83 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// collectionResolver resolves the references of components to the Profile collection which
+// they belong to, as the collection of a member is found: a reference by name belongs to the
+// collection of that name and namespace, and a reference without a name belongs to the only
+// collection in the cluster.  The collections are listed once, for the first reference
+// without a name.
+type collectionResolver struct {
+	reader client.Reader
+
+	listed bool
+	only   types.NamespacedName
+}
+
+// resolve returns the name and namespace of the collection which a component references, or
+// an empty name when the component belongs to no collection.
+func (resolver *collectionResolver) resolve(ctx context.Context, name, namespace string) (types.NamespacedName, error) {
+	if name != "" {
+		return types.NamespacedName{Name: name, Namespace: namespace}, nil
+	}
+
+	if !resolver.listed {
+		var collectionList v1beta1.ProfileList
+
+		if err := resolver.reader.List(ctx, &collectionList); err != nil {
+			return types.NamespacedName{}, fmt.Errorf("unable to list collections, %w", err)
+		}
+
+		if len(collectionList.Items) == 1 {
+			resolver.only = client.ObjectKeyFromObject(&collectionList.Items[0])
+		}
+
+		resolver.listed = true
+	}
+
+	return resolver.only, nil
+}
+
+// sameCollection determines whether two components reference the same collection, once their
+// references are resolved.  Components which belong to no collection share none.
+func (resolver *collectionResolver) sameCollection(
+	ctx context.Context,
+	name, namespace string,
+	otherName, otherNamespace string,
+) (bool, error) {
+	collection, err := resolver.resolve(ctx, name, namespace)
+	if err != nil || collection.Name == "" {
+		return false, err
+	}
+
+	other, err := resolver.resolve(ctx, otherName, otherNamespace)
+	if err != nil {
+		return false, err
+	}
+
+	return collection == other, nil
+}
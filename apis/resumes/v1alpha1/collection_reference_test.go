@@ -0,0 +1,76 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Collection references", func() {
+	// resolver returns a resolver of the given collections.
+	resolver := func(names ...string) *collectionResolver {
+		scheme := runtime.NewScheme()
+		Expect(v1beta1.AddToScheme(scheme)).To(Succeed())
+
+		collections := []client.Object{}
+		for _, name := range names {
+			collections = append(collections, &v1beta1.Profile{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "resumes"}})
+		}
+
+		return &collectionResolver{reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(collections...).Build()}
+	}
+
+	It("resolves a reference without a name to the only collection", func() {
+		only := resolver("jane")
+
+		same, err := only.sameCollection(ctx, "jane", "resumes", "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeTrue())
+
+		same, err = only.sameCollection(ctx, "", "", "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeTrue())
+
+		same, err = only.sameCollection(ctx, "jane", "other", "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeFalse())
+	})
+
+	It("resolves a reference without a name to no collection among several", func() {
+		several := resolver("jane", "john")
+
+		same, err := several.sameCollection(ctx, "jane", "resumes", "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeFalse())
+
+		same, err = several.sameCollection(ctx, "", "", "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeFalse())
+
+		same, err = several.sameCollection(ctx, "john", "resumes", "john", "resumes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(same).To(BeTrue())
+	})
+})
@@ -0,0 +1,178 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ErrInvalidDate = errors.New("invalid date")
+
+const (
+	// DatePresent is the marker used in place of an end date for an entry which is ongoing.
+	DatePresent = "present"
+
+	dateLayout     = "2006-01-02"
+	monthLayout    = "2006-01"
+	dateDisplay    = "Jan 2, 2006"
+	monthDisplay   = "Jan 2006"
+	presentDisplay = "Present"
+)
+
+// datePattern must be kept in sync with the validation pattern on Date.
+var datePattern = regexp.MustCompile(`^$|^[0-9]{4}-(0[1-9]|1[0-2])(-(0[1-9]|[12][0-9]|3[01]))?$|^[Pp]resent$`)
+
+// Date is a calendar date on a resume.  It accepts a full date (2006-01-02), a year and
+// month (2006-01) or the "present" marker for an entry which is ongoing.  An empty Date
+// means the date is not set.
+// +kubebuilder:validation:Pattern=`^$|^[0-9]{4}-(0[1-9]|1[0-2])(-(0[1-9]|[12][0-9]|3[01]))?$|^[Pp]resent$`
+type Date string
+
+// IsZero returns whether the date is unset.
+func (date Date) IsZero() bool {
+	return date == ""
+}
+
+// IsPresent returns whether the date is the "present" marker.
+func (date Date) IsPresent() bool {
+	return strings.EqualFold(string(date), DatePresent)
+}
+
+// Validate returns an error if the date is not in one of the accepted formats.
+func (date Date) Validate() error {
+	if !datePattern.MatchString(string(date)) {
+		return fmt.Errorf("%w %q, expected YYYY-MM-DD, YYYY-MM or %q", ErrInvalidDate, date, DatePresent)
+	}
+
+	if date.IsPresent() {
+		return nil
+	}
+
+	_, _, err := date.parse()
+
+	return err
+}
+
+// Time returns the date as a time.  The "present" marker returns the current time so that
+// ongoing entries sort after all others and tenure can be computed from them.  An unset
+// date returns the zero time.
+func (date Date) Time() (time.Time, error) {
+	if date.IsPresent() {
+		return time.Now().UTC(), nil
+	}
+
+	parsed, _, err := date.parse()
+
+	return parsed, err
+}
+
+// Normalized returns the date in its canonical form: YYYY-MM-DD, YYYY-MM or "present".
+// A date which cannot be parsed is returned unchanged.
+func (date Date) Normalized() string {
+	if date.IsPresent() {
+		return DatePresent
+	}
+
+	parsed, layout, err := date.parse()
+	if err != nil || layout == "" {
+		return string(date)
+	}
+
+	return parsed.Format(layout)
+}
+
+// Display returns the date as it is shown to a reader of the resume, e.g. "Jan 2006".
+// A date which cannot be parsed is returned unchanged.
+func (date Date) Display() string {
+	if date.IsPresent() {
+		return presentDisplay
+	}
+
+	parsed, layout, err := date.parse()
+	if err != nil || layout == "" {
+		return string(date)
+	}
+
+	if layout == monthLayout {
+		return parsed.Format(monthDisplay)
+	}
+
+	return parsed.Format(dateDisplay)
+}
+
+// parse returns the parsed date along with the layout which matched it.  An unset date
+// returns the zero time with an empty layout.
+func (date Date) parse() (time.Time, string, error) {
+	if date.IsZero() {
+		return time.Time{}, "", nil
+	}
+
+	for _, layout := range []string{dateLayout, monthLayout} {
+		if parsed, err := time.Parse(layout, string(date)); err == nil {
+			return parsed, layout, nil
+		}
+	}
+
+	return time.Time{}, "", fmt.Errorf("%w %q", ErrInvalidDate, date)
+}
+
+// Before returns whether the date is earlier than other, for ordering dates.  A year and
+// month is ordered as the first day of the month, ahead of a full date of that same day, so
+// that dates of mixed precision are ordered consistently.  The "present" marker is later
+// than every other date.  An unset or invalid date is never before or after another date.
+func (date Date) Before(other Date) bool {
+	if date.IsPresent() {
+		return false
+	}
+
+	left, leftLayout, err := date.parse()
+	if err != nil || leftLayout == "" {
+		return false
+	}
+
+	if other.IsPresent() {
+		return true
+	}
+
+	right, rightLayout, err := other.parse()
+	if err != nil || rightLayout == "" {
+		return false
+	}
+
+	if left.Equal(right) {
+		return leftLayout == monthLayout && rightLayout == dateLayout
+	}
+
+	return left.Before(right)
+}
+
+// precedes returns whether the date is earlier than other at the precision of the less
+// precise of the two, as a year and month spans the whole month: 2006-01 does not precede
+// 2006-01-15, nor does 2006-01-15 precede 2006-01.  It checks the order of the dates of a
+// range, where Before orders dates for sorting.
+func (date Date) precedes(other Date) bool {
+	left, right := date.Normalized(), other.Normalized()
+	if len(left) != len(right) && (strings.HasPrefix(left, right) || strings.HasPrefix(right, left)) {
+		return false
+	}
+
+	return date.Before(other)
+}
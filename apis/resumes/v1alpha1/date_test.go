@@ -0,0 +1,130 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Date", func() {
+	It("accepts a full date, a year and month, the present marker or nothing", func() {
+		for _, date := range []Date{"2006-01-02", "2006-01", "2006-12-31", "present", "Present", ""} {
+			Expect(date.Validate()).To(Succeed(), string(date))
+		}
+	})
+
+	It("rejects a year alone and any other date", func() {
+		for _, date := range []Date{"2006", "2006-13", "2006-00", "2006-02-30", "2006-1-2", "01/2006", "presently", "now"} {
+			Expect(date.Validate()).To(MatchError(ErrInvalidDate), string(date))
+		}
+	})
+
+	It("validates dates with the pattern of the CRDs", func() {
+		document, err := os.ReadFile(filepath.Join("..", "..", "..", "config", "crd", "bases", "resumes.jefedavis.dev_jobexperiences.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+
+		crd := map[string]interface{}{}
+		Expect(yaml.Unmarshal(document, &crd)).To(Succeed())
+
+		versions, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+		Expect(versions).NotTo(BeEmpty())
+
+		pattern, found, err := unstructured.NestedString(versions[0].(map[string]interface{}),
+			"schema", "openAPIV3Schema", "properties", "spec", "properties", "startDate", "pattern")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(pattern).To(Equal(datePattern.String()))
+	})
+
+	It("normalizes a date to its canonical form", func() {
+		Expect(Date("2006-01-02").Normalized()).To(Equal("2006-01-02"))
+		Expect(Date("2006-01").Normalized()).To(Equal("2006-01"))
+		Expect(Date("Present").Normalized()).To(Equal(DatePresent))
+		Expect(Date("").Normalized()).To(BeEmpty())
+		Expect(Date("2006").Normalized()).To(Equal("2006"))
+	})
+
+	It("displays a date at its precision", func() {
+		Expect(Date("2006-01-02").Display()).To(Equal("Jan 2, 2006"))
+		Expect(Date("2006-01").Display()).To(Equal("Jan 2006"))
+		Expect(Date("present").Display()).To(Equal("Present"))
+		Expect(Date("").Display()).To(BeEmpty())
+		Expect(Date("2006").Display()).To(Equal("2006"))
+	})
+
+	It("returns the time of a date", func() {
+		Expect(Date("2006-01-02").Time()).To(Equal(time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)))
+		Expect(Date("2006-01").Time()).To(Equal(time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(Date("").Time()).To(BeZero())
+
+		present, err := Date("present").Time()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(present).To(BeTemporally("~", time.Now(), time.Minute))
+
+		_, err = Date("2006").Time()
+		Expect(err).To(MatchError(ErrInvalidDate))
+	})
+
+	It("orders dates of mixed precision consistently", func() {
+		Expect(Date("2006-01").Before("2006-01-15")).To(BeTrue())
+		Expect(Date("2006-01-15").Before("2006-01")).To(BeFalse())
+		Expect(Date("2006-01").Before("2006-01-01")).To(BeTrue())
+		Expect(Date("2006-01-01").Before("2006-01")).To(BeFalse())
+		Expect(Date("2006-01-31").Before("2006-02")).To(BeTrue())
+		Expect(Date("2006-01").Before("2006-01")).To(BeFalse())
+
+		ordered := []Date{"2005-12-31", "2006-01", "2006-01-01", "2006-01-02", "2006-01-15", "2006-02", "present"}
+
+		for _, order := range [][]int{{6, 5, 4, 3, 2, 1, 0}, {4, 1, 3, 0, 6, 2, 5}, {3, 4, 1, 2, 5, 0, 6}} {
+			dates := []Date{}
+			for _, i := range order {
+				dates = append(dates, ordered[i])
+			}
+
+			sort.SliceStable(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+			Expect(dates).To(Equal(ordered))
+		}
+	})
+
+	It("orders the present marker after every date, and an unset or invalid date nowhere", func() {
+		Expect(Date("2999-12-31").Before(DatePresent)).To(BeTrue())
+		Expect(Date(DatePresent).Before("2999-12-31")).To(BeFalse())
+		Expect(Date(DatePresent).Before("Present")).To(BeFalse())
+
+		for _, date := range []Date{"", "2006"} {
+			Expect(date.Before("2006-01")).To(BeFalse(), string(date))
+			Expect(Date("2006-01").Before(date)).To(BeFalse(), string(date))
+		}
+	})
+
+	It("compares the dates of a range at the precision of the less precise date", func() {
+		Expect(Date("2006-01").precedes("2006-01-15")).To(BeFalse())
+		Expect(Date("2006-01-15").precedes("2006-01")).To(BeFalse())
+		Expect(Date("2006-01-02").precedes("2006-01-15")).To(BeTrue())
+		Expect(Date("2005-12").precedes("2006-01-15")).To(BeTrue())
+		Expect(Date("2006-01").precedes(DatePresent)).To(BeTrue())
+	})
+})
@@ -0,0 +1,128 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package education
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+)
+
+// ConfigMapName returns the name of the ConfigMap which holds the rendered data for an
+// Education.  Each Education renders into its own ConfigMap so that one member
+// of the collection never overwrites the data of another.
+func ConfigMapName(parent *resumesv1alpha1.Education) string {
+	return "resume-education-" + parent.Name
+}
+
+// educationData is the data file of an Education for the hugo renderer.
+type educationData struct {
+	School           string   `json:"school"`
+	Degree           string   `json:"degree"`
+	FieldOfStudy     string   `json:"fieldOfStudy"`
+	Location         string   `json:"location"`
+	StartDate        string   `json:"startDate"`
+	StartDateDisplay string   `json:"startDateDisplay"`
+	EndDate          string   `json:"endDate"`
+	EndDateDisplay   string   `json:"endDateDisplay"`
+	GPA              string   `json:"gpa"`
+	Honors           []string `json:"honors"`
+	Coursework       []string `json:"coursework"`
+}
+
+// Data returns the rendered data file for an Education.
+func Data(parent *resumesv1alpha1.Education) (string, error) {
+	data, err := yaml.Marshal(educationData{
+		School:           parent.Spec.School,
+		Degree:           parent.Spec.Degree,
+		FieldOfStudy:     parent.Spec.FieldOfStudy,
+		Location:         parent.Spec.Location,
+		StartDate:        parent.Spec.StartDate.Normalized(),
+		StartDateDisplay: parent.Spec.StartDate.Display(),
+		EndDate:          parent.Spec.EndDate.Normalized(),
+		EndDateDisplay:   parent.Spec.EndDate.Display(),
+		GPA:              parent.Spec.GPA,
+		Honors:           parent.Spec.Honors,
+		Coursework:       parent.Spec.Coursework,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to scaffold education yaml for ConfigMap, %w", err)
+	}
+
+	return string(data), nil
+}
+
+// CreateConfigMapResumeEducation creates the resume-education ConfigMap resource.
+func CreateConfigMapResumeEducation(
+	parent *resumesv1alpha1.Education,
+	collection *resumesv1alpha1.Profile,
+) ([]client.Object, error) {
+	fileName := strings.ReplaceAll(parent.Spec.School, " ", "-")
+	fileName = strings.ReplaceAll(fileName, ".", "")
+	fileName = strings.ReplaceAll(fileName, ",", "")
+
+	data, err := Data(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ConfigMapName(parent),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "hugo",
+					"app.kubernetes.io/component": "data",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by collection field: profile.firstName
+					// controlled by collection field: profile.lastName
+					"app.kubernetes.io/instance":   "resume-" + collection.Spec.Profile.FirstName + "" + collection.Spec.Profile.LastName + "",
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by collection field: web.image.tag
+					"app.kubernetes.io/version": collection.Spec.Web.Image.Tag,
+				},
+			},
+			"data": map[string]interface{}{
+				// controlled by field: school
+				// controlled by field: degree
+				// controlled by field: fieldOfStudy
+				// controlled by field: location
+				// controlled by field: startDate
+				// controlled by field: endDate
+				// controlled by field: gpa
+				// controlled by field: honors
+				// controlled by field: coursework
+				fmt.Sprintf("%s.yaml", fileName): data,
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var ErrUnableToConvertEducation = errors.New("unable to convert to Education")
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// EducationSpec defines the desired state of Education.
+type EducationSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection EducationCollectionSpec `json:"collection"`
+
+	School string `json:"school,omitempty"`
+
+	Degree string `json:"degree,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	FieldOfStudy string `json:"fieldOfStudy,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Location string `json:"location,omitempty"`
+
+	StartDate Date `json:"startDate,omitempty"`
+
+	EndDate Date `json:"endDate,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	GPA string `json:"gpa,omitempty"`
+
+	// +kubebuilder:default={}
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Honors []string `json:"honors,omitempty"`
+
+	// +kubebuilder:default={}
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Coursework []string `json:"coursework,omitempty"`
+}
+
+type EducationCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+// EducationStatus defines the observed state of Education.
+type EducationStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Whether the Profile collection which the Education belongs to is found.
+	CollectionConditions []metav1.Condition `json:"collectionConditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Education is the Schema for the educations API.
+type Education struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              EducationSpec   `json:"spec,omitempty"`
+	Status            EducationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EducationList contains a list of Education.
+type EducationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Education `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *Education) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *Education) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *Education) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *Education) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *Education) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *Education) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// SetCollectionCondition sets the condition of the collection of a component.
+func (component *Education) SetCollectionCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&component.Status.CollectionConditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *Education) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *Education) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*Education) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*Education) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("Education")
+}
+
+func init() {
+	SchemeBuilder.Register(&Education{}, &EducationList{})
+}
@@ -40,7 +40,7 @@ spec:
   employer: "Employer"
   location: "Location"
   startDate: "2006-01-02"
-  endDate: "Present"
+  endDate: "present"
   positions:
     - title: "Title"
       startDate: ""
@@ -61,7 +61,7 @@ spec:
   employer: "Employer"
   location: "Location"
   startDate: "2006-01-02"
-  endDate: "Present"
+  endDate: "present"
   positions:
     - title: "Title"
 `
@@ -17,32 +17,95 @@ limitations under the License.
 package experience
 
 import (
-	"bytes"
 	"fmt"
-	"strings"
-	"text/template"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
 )
 
+// ConfigMapName returns the name of the ConfigMap which holds the rendered data for a
+// JobExperience.  Each JobExperience renders into its own ConfigMap so that one member
+// of the collection never overwrites the data of another.
+func ConfigMapName(parent *resumesv1alpha1.JobExperience) string {
+	return "resume-experience-" + parent.Name
+}
+
+// DataKey returns the name of the data file for a JobExperience within the experience
+// directory of the resume site.  It is named after the JobExperience rather than its
+// employer, as several JobExperiences may share an employer.
+func DataKey(parent *resumesv1alpha1.JobExperience) string {
+	return fmt.Sprintf("%s.yaml", parent.Name)
+}
+
+// experienceData is the data file of a JobExperience for the hugo renderer.
+type experienceData struct {
+	Employer         string         `json:"employer"`
+	Location         string         `json:"location"`
+	StartDate        string         `json:"startDate"`
+	StartDateDisplay string         `json:"startDateDisplay"`
+	EndDate          string         `json:"endDate"`
+	EndDateDisplay   string         `json:"endDateDisplay"`
+	Positions        []positionData `json:"positions"`
+}
+
+// positionData is a position within the data file of a JobExperience.
+type positionData struct {
+	Title            string   `json:"title"`
+	StartDate        string   `json:"startDate"`
+	StartDateDisplay string   `json:"startDateDisplay"`
+	EndDate          string   `json:"endDate"`
+	EndDateDisplay   string   `json:"endDateDisplay"`
+	Highlights       []string `json:"highlights"`
+}
+
+// Data returns the rendered data file for a JobExperience, with the highlights of each
+// position tailored to the audience of the collection.
+func Data(
+	parent *resumesv1alpha1.JobExperience,
+	collection *resumesv1alpha1.Profile,
+) (string, error) {
+	experience := experienceData{
+		Employer:         parent.Spec.Employer,
+		Location:         parent.Spec.Location,
+		StartDate:        parent.Spec.StartDate.Normalized(),
+		StartDateDisplay: parent.Spec.StartDate.Display(),
+		EndDate:          parent.Spec.EndDate.Normalized(),
+		EndDateDisplay:   parent.Spec.EndDate.Display(),
+		Positions:        []positionData{},
+	}
+
+	for _, position := range parent.Spec.Positions {
+		experience.Positions = append(experience.Positions, positionData{
+			Title:            position.Title,
+			StartDate:        position.StartDate.Normalized(),
+			StartDateDisplay: position.StartDate.Display(),
+			EndDate:          position.EndDate.Normalized(),
+			EndDateDisplay:   position.EndDate.Display(),
+			Highlights: resumesv1alpha1.ItemTexts(
+				resumesv1alpha1.TailorItems(position.Highlights, collection.Spec.Audience),
+			),
+		})
+	}
+
+	data, err := yaml.Marshal(experience)
+	if err != nil {
+		return "", fmt.Errorf("unable to scaffold experience yaml for ConfigMap, %w", err)
+	}
+
+	return string(data), nil
+}
+
 // CreateConfigMapResumeExperience creates the resume-experience ConfigMap resource.
 func CreateConfigMapResumeExperience(
 	parent *resumesv1alpha1.JobExperience,
 	collection *resumesv1alpha1.Profile,
 ) ([]client.Object, error) {
-	fileName := strings.ReplaceAll(parent.Spec.Employer, " ", "-")
-	fileName = strings.ReplaceAll(fileName, ".", "")
-	fileName = strings.ReplaceAll(fileName, ",", "")
-
-	var experienceBuffer bytes.Buffer
-
-	experience := template.New("Experience")
-	experience, _ = experience.Parse(experienceTemplate)
-	if err := experience.Execute(&experienceBuffer, *parent); err != nil {
-		return nil, fmt.Errorf("unable to scaffold experience yaml for ConfigMap, %w", err)
+	data, err := Data(parent, collection)
+	if err != nil {
+		return nil, err
 	}
 
 	resourceObjs := []client.Object{}
@@ -51,7 +114,7 @@ func CreateConfigMapResumeExperience(
 			"apiVersion": "v1",
 			"kind":       "ConfigMap",
 			"metadata": map[string]interface{}{
-				"name": "resume-experience",
+				"name": ConfigMapName(parent),
 				"labels": map[string]interface{}{
 					"app.kubernetes.io/name":      "hugo",
 					"app.kubernetes.io/component": "data",
@@ -74,7 +137,8 @@ func CreateConfigMapResumeExperience(
 				// controlled by field: position.startDate
 				// controlled by field: position.endDate
 				// controlled by field: position.highlights
-				fmt.Sprintf("%s.yaml", fileName): experienceBuffer.String(),
+				// controlled by collection field: audience
+				DataKey(parent): data,
 			},
 		},
 	}
@@ -85,21 +149,3 @@ func CreateConfigMapResumeExperience(
 
 	return resourceObjs, nil
 }
-
-const experienceTemplate = `
----
-employer: {{ .Spec.Employer }}
-location: {{ .Spec.Location }}
-startDate: {{ .Spec.StartDate }}
-endDate: {{ .Spec.EndDate }}
-positions:
-{{- range .Spec.Positions }}
-  - title: {{ .Title }}
-    startDate: {{ .StartDate }}
-    endDate: {{ .EndDate }}
-    highlights: 
-		{{- range .Highlights }}
-      - {{ . }}
-		{{- end }}
-{{- end }}
-`
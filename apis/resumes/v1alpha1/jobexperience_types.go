@@ -21,6 +21,7 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -47,9 +48,9 @@ type JobExperienceSpec struct {
 
 	Location string `json:"location,omitempty"`
 
-	StartDate string `json:"startDate,omitempty"`
+	StartDate Date `json:"startDate,omitempty"`
 
-	EndDate string `json:"endDate,omitempty"`
+	EndDate Date `json:"endDate,omitempty"`
 
 	// +kubebuilder:validation:Optional
 	Positions []JobExperienceSpecPosition `json:"positions,omitempty"`
@@ -73,17 +74,19 @@ type JobExperienceSpecPosition struct {
 	// +kubebuilder:default=""
 	// +kubebuilder:validation:Optional
 	// (Default: "")
-	StartDate string `json:"startDate,omitempty"`
+	StartDate Date `json:"startDate,omitempty"`
 
 	// +kubebuilder:default=""
 	// +kubebuilder:validation:Optional
 	// (Default: "")
-	EndDate string `json:"endDate,omitempty"`
+	EndDate Date `json:"endDate,omitempty"`
 
 	// +kubebuilder:default={}
 	// +kubebuilder:validation:Optional
 	// (Default: "")
-	Highlights []string `json:"highlights,omitempty"`
+	// Each highlight is written either as plain text or with tags, a priority and whether it
+	// is hidden, so that the resume may be tailored to an audience.
+	Highlights []TaggedItem `json:"highlights,omitempty"`
 }
 
 // JobExperienceStatus defines the observed state of JobExperience.
@@ -95,6 +98,12 @@ type JobExperienceStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Whether the Profile collection which the JobExperience belongs to is found.
+	CollectionConditions []metav1.Condition `json:"collectionConditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -158,6 +167,11 @@ func (component *JobExperience) SetPhaseCondition(condition *status.PhaseConditi
 	component.Status.Conditions = append(component.Status.Conditions, condition)
 }
 
+// SetCollectionCondition sets the condition of the collection of a component.
+func (component *JobExperience) SetCollectionCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&component.Status.CollectionConditions, condition)
+}
+
 // GetResources returns the child resource status for a component.
 func (component *JobExperience) GetChildResourceConditions() []*status.ChildResource {
 	return component.Status.Resources
@@ -0,0 +1,114 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var jobexperiencelog = logf.Log.WithName("jobexperience-resource")
+
+func (r *JobExperience) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-resumes-jefedavis-dev-v1alpha1-jobexperience,mutating=false,failurePolicy=fail,sideEffects=None,groups=resumes.jefedavis.dev,resources=jobexperiences,verbs=create;update,versions=v1alpha1,name=vjobexperience.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &JobExperience{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *JobExperience) ValidateCreate() error {
+	jobexperiencelog.Info("validate create", "name", r.Name)
+
+	return r.validateJobExperience()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *JobExperience) ValidateUpdate(old runtime.Object) error {
+	jobexperiencelog.Info("validate update", "name", r.Name)
+
+	return r.validateJobExperience()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *JobExperience) ValidateDelete() error {
+	return nil
+}
+
+// validateJobExperience checks that each date is valid, that no date range ends before it
+// starts and that every position falls within the dates of the employer.
+func (r *JobExperience) validateJobExperience() error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validateDateRange(specPath, r.Spec.StartDate, r.Spec.EndDate)
+
+	for i, position := range r.Spec.Positions {
+		positionPath := specPath.Child("positions").Index(i)
+
+		allErrs = append(allErrs, validateDateRange(positionPath, position.StartDate, position.EndDate)...)
+
+		if position.StartDate.precedes(r.Spec.StartDate) {
+			allErrs = append(allErrs, field.Invalid(positionPath.Child("startDate"), position.StartDate,
+				"position must not start before the employer startDate "+string(r.Spec.StartDate)))
+		}
+
+		if r.Spec.EndDate.precedes(position.StartDate) {
+			allErrs = append(allErrs, field.Invalid(positionPath.Child("startDate"), position.StartDate,
+				"position must not start after the employer endDate "+string(r.Spec.EndDate)))
+		}
+
+		if r.Spec.EndDate.precedes(position.EndDate) {
+			allErrs = append(allErrs, field.Invalid(positionPath.Child("endDate"), position.EndDate,
+				"position must not end after the employer endDate "+string(r.Spec.EndDate)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrs.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "JobExperience"}, r.Name, allErrs)
+}
+
+// validateDateRange checks the startDate and endDate below path are valid dates and that
+// the range does not end before it starts.
+func validateDateRange(path *field.Path, startDate, endDate Date) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := startDate.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("startDate"), startDate, err.Error()))
+	}
+
+	if err := endDate.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("endDate"), endDate, err.Error()))
+	}
+
+	if endDate.precedes(startDate) {
+		allErrs = append(allErrs, field.Invalid(path.Child("endDate"), endDate, "must not be before startDate "+string(startDate)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// ProfileSpecAnnotation holds the v1beta1 spec of a Profile which is read as v1alpha1, so
+// that the fields which v1alpha1 cannot represent survive a round trip through v1alpha1.
+const ProfileSpecAnnotation = "resumes.jefedavis.dev/v1beta1-spec"
+
+var _ conversion.Convertible = &Profile{}
+
+// ConvertTo converts this Profile to the hub version (v1beta1).
+func (src *Profile) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1beta1.Profile)
+	if !ok {
+		return fmt.Errorf("%w, unexpected hub type %T", ErrUnableToConvertProfile, dstRaw)
+	}
+
+	// start from the spec which was preserved when this Profile was converted from the hub
+	// so that any fields which are not represented here are kept
+	var preserved v1beta1.ProfileSpec
+
+	if raw, ok := src.Annotations[ProfileSpecAnnotation]; ok {
+		if err := json.Unmarshal([]byte(raw), &preserved); err != nil {
+			return fmt.Errorf("unable to read annotation %s, %w", ProfileSpecAnnotation, err)
+		}
+	}
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	delete(dst.Annotations, ProfileSpecAnnotation)
+
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	dst.Spec = preserved
+	dst.Spec.BaseURL = src.Spec.BaseURL
+	dst.Spec.PageTitle = src.Spec.PageTitle
+	dst.Spec.Audience = src.Spec.Audience
+	dst.Spec.CertIssuer = src.Spec.CertIssuer
+	dst.Spec.IngressClass = src.Spec.IngressClass
+	dst.Spec.Web.Image = v1beta1.ProfileSpecWebImage(src.Spec.Web.Image)
+	dst.Spec.Pdf.Image = v1beta1.ProfileSpecPdfImage(src.Spec.Pdf.Image)
+
+	dst.Spec.PageCount = 0
+
+	if src.Spec.PageCount != "" {
+		pageCount, err := strconv.Atoi(src.Spec.PageCount)
+		if err != nil {
+			return fmt.Errorf("%w, pageCount %q must be a whole number", ErrUnableToConvertProfile, src.Spec.PageCount)
+		}
+
+		dst.Spec.PageCount = pageCount
+	}
+
+	profile := src.Spec.Profile
+
+	dst.Spec.Profile.FirstName = profile.FirstName
+	dst.Spec.Profile.LastName = profile.LastName
+	dst.Spec.Profile.PhoneNumber = profile.PhoneNumber
+	dst.Spec.Profile.Email = profile.Email
+	dst.Spec.Profile.LinkedinURL = profile.LinkedinURL
+	dst.Spec.Profile.GithubURL = profile.GithubURL
+	dst.Spec.Profile.Location = profile.Location
+	dst.Spec.Profile.Overview = profile.Overview
+	dst.Spec.Profile.CoreCompetencies = profile.CoreCompetencies
+
+	dst.Spec.Profile.Skills = nil
+
+	for _, skill := range profile.Skills {
+		dst.Spec.Profile.Skills = append(dst.Spec.Profile.Skills, v1beta1.ProfileSpecSkillFamily{
+			Family: skill.Family,
+			Items:  convertItemsTo(skill.Items),
+		})
+	}
+
+	// keep the title and description of projects whose url is unchanged
+	details := map[string]v1beta1.ProfileSpecProject{}
+	for _, project := range preserved.Profile.Projects {
+		details[project.URL] = project
+	}
+
+	dst.Spec.Profile.Projects = nil
+
+	for _, projectURL := range profile.Projects {
+		project, ok := details[projectURL]
+		if !ok {
+			project = v1beta1.ProfileSpecProject{URL: projectURL}
+		}
+
+		dst.Spec.Profile.Projects = append(dst.Spec.Profile.Projects, project)
+	}
+
+	dst.Status = v1beta1.ProfileStatus(src.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this version.
+func (dst *Profile) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1beta1.Profile)
+	if !ok {
+		return fmt.Errorf("%w, unexpected hub type %T", ErrUnableToConvertProfile, srcRaw)
+	}
+
+	preserved, err := json.Marshal(src.Spec)
+	if err != nil {
+		return fmt.Errorf("unable to write annotation %s, %w", ProfileSpecAnnotation, err)
+	}
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+
+	dst.Annotations[ProfileSpecAnnotation] = string(preserved)
+
+	dst.Spec.BaseURL = src.Spec.BaseURL
+	dst.Spec.PageTitle = src.Spec.PageTitle
+	dst.Spec.Audience = src.Spec.Audience
+	dst.Spec.CertIssuer = src.Spec.CertIssuer
+	dst.Spec.IngressClass = src.Spec.IngressClass
+	dst.Spec.Web.Image = ProfileSpecWebImage(src.Spec.Web.Image)
+	dst.Spec.Pdf.Image = ProfileSpecPdfImage(src.Spec.Pdf.Image)
+
+	dst.Spec.PageCount = ""
+
+	if src.Spec.PageCount != 0 {
+		dst.Spec.PageCount = strconv.Itoa(src.Spec.PageCount)
+	}
+
+	profile := src.Spec.Profile
+
+	dst.Spec.Profile.FirstName = profile.FirstName
+	dst.Spec.Profile.LastName = profile.LastName
+	dst.Spec.Profile.PhoneNumber = profile.PhoneNumber
+	dst.Spec.Profile.Email = profile.Email
+	dst.Spec.Profile.LinkedinURL = profile.LinkedinURL
+	dst.Spec.Profile.GithubURL = profile.GithubURL
+	dst.Spec.Profile.Location = profile.Location
+	dst.Spec.Profile.Overview = profile.Overview
+	dst.Spec.Profile.CoreCompetencies = profile.CoreCompetencies
+
+	dst.Spec.Profile.Skills = nil
+
+	for _, skill := range profile.Skills {
+		dst.Spec.Profile.Skills = append(dst.Spec.Profile.Skills, ProfileSpecSkillFamily{
+			Family: skill.Family,
+			Items:  convertItems(skill.Items),
+		})
+	}
+
+	dst.Spec.Profile.Projects = nil
+
+	for _, project := range profile.Projects {
+		dst.Spec.Profile.Projects = append(dst.Spec.Profile.Projects, project.URL)
+	}
+
+	dst.Status = ProfileStatus(src.Status)
+
+	return nil
+}
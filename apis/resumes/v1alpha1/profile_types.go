@@ -21,6 +21,7 @@ import (
 
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -46,11 +47,16 @@ type ProfileSpec struct {
 	// (Default: "example.com")
 	BaseURL string `json:"baseURL,omitempty"`
 
-	// +kubebuilder:default="John Doe - CV"
 	// +kubebuilder:validation:Optional
-	// (Default: "John Doe - CV")
+	// (Default: "<profile.firstName> <profile.lastName> - CV")
 	PageTitle string `json:"pageTitle,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// The audience which the resume is tailored to.  When set, the highlights and skills
+	// which are tagged for other audiences are left out, and those which are tagged for this
+	// audience are rendered first.
+	Audience []string `json:"audience,omitempty"`
+
 	// +kubebuilder:default="1"
 	// +kubebuilder:validation:Optional
 	// (Default: "1")
@@ -119,6 +125,8 @@ type ProfileSpecProfile struct {
 	// +kubebuilder:default={}
 	// +kubebuilder:validation:optional
 	// (Default: "")
+	// Deprecated: use Project resources which belong to this collection instead.  Each
+	// URL is rendered as a Project which only has a repository link.
 	Projects []string `json:"projects,omitempty"`
 
 	// +kubebuilder:default={}
@@ -128,8 +136,8 @@ type ProfileSpecProfile struct {
 }
 
 type ProfileSpecSkillFamily struct {
-	Family string   `json:"family,omitempty"`
-	Items  []string `json:"items,omitempty"`
+	Family string       `json:"family,omitempty"`
+	Items  []TaggedItem `json:"items,omitempty"`
 }
 
 type ProfileSpecWeb struct {
@@ -138,9 +146,8 @@ type ProfileSpecWeb struct {
 }
 
 type ProfileSpecWebImage struct {
-	// +kubebuilder:default="latest"
 	// +kubebuilder:validation:Optional
-	// (Default: "latest")
+	// (Default: the version of the operator)
 	Tag string `json:"tag,omitempty"`
 
 	// +kubebuilder:default=""
@@ -170,14 +177,14 @@ type ProfileSpecPdfImage struct {
 	// (Default: "")
 	Registry string `json:"registry,omitempty"`
 
-	// +kubebuilder:default="jefedavis/resume"
+	// +kubebuilder:default="jefedavis/resume-pdf-converter"
 	// +kubebuilder:validation:Optional
-	// (Default: "jefedavis/resume")
+	// (Default: "jefedavis/resume-pdf-converter")
 	Name string `json:"name,omitempty"`
 
-	// +kubebuilder:default="latest"
+	// +kubebuilder:default="v0.1.0"
 	// +kubebuilder:validation:Optional
-	// (Default: "latest")
+	// (Default: "v0.1.0")
 	Tag string `json:"tag,omitempty"`
 
 	// +kubebuilder:default="IfNotPresent"
@@ -195,10 +202,63 @@ type ProfileStatus struct {
 	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
 	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
 	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Readiness of each of the workloads which serve the resume, by the type of condition.
+	ReadinessConditions []metav1.Condition `json:"readinessConditions,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Conditions of the last render of the resume, such as whether it fits the pages of the PDF.
+	RenderConditions []metav1.Condition `json:"renderConditions,omitempty"`
+
+	// +optional
+	// URL at which the resume site is served.
+	URL string `json:"url,omitempty"`
+
+	// +optional
+	// URL at which the PDF of the resume is downloaded.
+	PdfURL string `json:"pdfURL,omitempty"`
+
+	// +optional
+	// Number of JobExperiences which are rendered into the resume.
+	JobExperienceCount int32 `json:"jobExperienceCount"`
+
+	// +optional
+	// Number of Certifications which are rendered into the resume.
+	CertificationCount int32 `json:"certificationCount"`
+
+	// +optional
+	// Members of the collection which are rendered into the resume, including those which are
+	// adopted from other namespaces by the memberSelector.
+	Members []corev1.ObjectReference `json:"members,omitempty"`
+
+	// +optional
+	// Generation of the Profile which was last rendered.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	// Time at which the rendered resume last changed.
+	LastRenderTime *metav1.Time `json:"lastRenderTime,omitempty"`
+
+	// +optional
+	// Checksum of the resources which were last rendered for the resume.
+	LastRenderHash string `json:"lastRenderHash,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`
+// +kubebuilder:printcolumn:name="PDF",type=string,JSONPath=`.status.pdfURL`,priority=1
+// +kubebuilder:printcolumn:name="Experiences",type=integer,JSONPath=`.status.jobExperienceCount`
+// +kubebuilder:printcolumn:name="Certifications",type=integer,JSONPath=`.status.certificationCount`
+// +kubebuilder:printcolumn:name="Generation",type=integer,JSONPath=`.status.observedGeneration`,priority=1
+// +kubebuilder:printcolumn:name="Last Render",type=date,JSONPath=`.status.lastRenderTime`
+// +kubebuilder:printcolumn:name="Hash",type=string,JSONPath=`.status.lastRenderHash`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Profile is the Schema for the profiles API.
 type Profile struct {
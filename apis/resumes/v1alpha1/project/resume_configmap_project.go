@@ -0,0 +1,126 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+)
+
+// ConfigMapName returns the name of the ConfigMap which holds the rendered data for a
+// Project.  Each Project renders into its own ConfigMap so that one member
+// of the collection never overwrites the data of another.
+func ConfigMapName(parent *resumesv1alpha1.Project) string {
+	return "resume-project-" + parent.Name
+}
+
+// DataKey returns the name of the data file for a Project within the projects
+// directory of the resume site.
+func DataKey(parent *resumesv1alpha1.Project) string {
+	return fmt.Sprintf("%s.yaml", parent.Name)
+}
+
+// projectData is the data file of a Project for the hugo renderer.
+type projectData struct {
+	Title            string   `json:"title"`
+	Description      string   `json:"description"`
+	Role             string   `json:"role"`
+	TechStack        []string `json:"techStack"`
+	StartDate        string   `json:"startDate"`
+	StartDateDisplay string   `json:"startDateDisplay"`
+	EndDate          string   `json:"endDate"`
+	EndDateDisplay   string   `json:"endDateDisplay"`
+	RepoURL          string   `json:"repoURL"`
+	DemoURL          string   `json:"demoURL"`
+}
+
+// Data returns the rendered data file for a Project.
+func Data(parent *resumesv1alpha1.Project) (string, error) {
+	data, err := yaml.Marshal(projectData{
+		Title:            parent.Spec.Title,
+		Description:      parent.Spec.Description,
+		Role:             parent.Spec.Role,
+		TechStack:        parent.Spec.TechStack,
+		StartDate:        parent.Spec.StartDate.Normalized(),
+		StartDateDisplay: parent.Spec.StartDate.Display(),
+		EndDate:          parent.Spec.EndDate.Normalized(),
+		EndDateDisplay:   parent.Spec.EndDate.Display(),
+		RepoURL:          parent.Spec.RepoURL,
+		DemoURL:          parent.Spec.DemoURL,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to scaffold project yaml for ConfigMap, %w", err)
+	}
+
+	return string(data), nil
+}
+
+// CreateConfigMapResumeProject creates the resume-project ConfigMap resource.
+func CreateConfigMapResumeProject(
+	parent *resumesv1alpha1.Project,
+	collection *resumesv1alpha1.Profile,
+) ([]client.Object, error) {
+	data, err := Data(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ConfigMapName(parent),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "hugo",
+					"app.kubernetes.io/component": "data",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by collection field: profile.firstName
+					// controlled by collection field: profile.lastName
+					"app.kubernetes.io/instance":   "resume-" + collection.Spec.Profile.FirstName + "" + collection.Spec.Profile.LastName + "",
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by collection field: web.image.tag
+					"app.kubernetes.io/version": collection.Spec.Web.Image.Tag,
+				},
+			},
+			"data": map[string]interface{}{
+				// controlled by field: title
+				// controlled by field: description
+				// controlled by field: role
+				// controlled by field: techStack
+				// controlled by field: startDate
+				// controlled by field: endDate
+				// controlled by field: repoURL
+				// controlled by field: demoURL
+				DataKey(parent): data,
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
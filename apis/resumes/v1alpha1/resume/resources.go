@@ -25,6 +25,8 @@ import (
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	v1beta1resume "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 )
 
 // sampleProfile is a sample containing all fields
@@ -48,7 +50,7 @@ spec:
     skills: ""
   web:
     image:
-      tag: "latest"
+      tag: "v0.1.0"
       registry: ""
       name: "jefedavis/resume"
       pullPolicy: "IfNotPresent"
@@ -58,8 +60,8 @@ spec:
   pdf:
     image:
       registry: ""
-      name: "jefedavis/resume"
-      tag: "latest"
+      name: "jefedavis/resume-pdf-converter"
+      tag: "v0.1.0"
       pullPolicy: "IfNotPresent"
   certIssuer: "letsencrypt-staging"
   ingressClass: "nginx"
@@ -83,26 +85,38 @@ func Sample(requiredOnly bool) string {
 	return sampleProfile
 }
 
-// Generate returns the child resources that are associated with this workload given
-// appropriate structured inputs.
-func Generate(collectionObj resumesv1alpha1.Profile) ([]client.Object, error) {
-	resourceObjects := []client.Object{}
+// GenerateForCLI returns the child resources that are associated with this workload given
+// appropriate YAML manifest files.  The child resources are generated by the v1beta1 API, so
+// the collection is converted to v1beta1 first, as the API server would.
+func GenerateForCLI(collectionFile []byte) ([]client.Object, error) {
+	hubObj, err := CollectionForCLI(collectionFile)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, f := range CreateFuncs {
-		resources, err := f(&collectionObj)
-		if err != nil {
-			return nil, err
-		}
+	return v1beta1resume.Generate(*hubObj, v1beta1resume.Members{})
+}
+
+// GenerateVariantForCLI returns the child resources of a ResumeVariant of this collection
+// given appropriate YAML manifest files.
+func GenerateVariantForCLI(workloadFile []byte, collectionFile []byte) ([]client.Object, error) {
+	hubObj, err := CollectionForCLI(collectionFile)
+	if err != nil {
+		return nil, err
+	}
 
-		resourceObjects = append(resourceObjects, resources...)
+	workloadObj, err := v1beta1resume.VariantForCLI(workloadFile)
+	if err != nil {
+		return nil, err
 	}
 
-	return resourceObjects, nil
+	return v1beta1resume.GenerateVariant(*hubObj, v1beta1resume.Members{}, *workloadObj)
 }
 
-// GenerateForCLI returns the child resources that are associated with this workload given
-// appropriate YAML manifest files.
-func GenerateForCLI(collectionFile []byte) ([]client.Object, error) {
+// CollectionForCLI returns the collection of a YAML manifest file as a v1beta1 Profile with
+// the same defaults as the defaulting webhook, so that what is built from the collection
+// matches what the controller would deploy.
+func CollectionForCLI(collectionFile []byte) (*resumesv1beta1.Profile, error) {
 	var collectionObj resumesv1alpha1.Profile
 	if err := yaml.Unmarshal(collectionFile, &collectionObj); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal yaml into collection, %w", err)
@@ -112,41 +126,12 @@ func GenerateForCLI(collectionFile []byte) ([]client.Object, error) {
 		return nil, fmt.Errorf("error validating collection yaml, %w", err)
 	}
 
-	return Generate(collectionObj)
-}
-
-// CreateFuncs is an array of functions that are called to create the child resources for the controller
-// in memory during the reconciliation loop prior to persisting the changes or updates to the Kubernetes
-// database.
-var CreateFuncs = []func(
-	*resumesv1alpha1.Profile,
-) ([]client.Object, error){
-	CreateConfigMapResumeConfig,
-	CreateConfigMapResumeProfile,
-	CreateDeploymentResume,
-	CreateDeploymentPdfConverter,
-	CreateServicePdfConverterSvc,
-	CreateServiceResumeSvc,
-	CreateIngressResume,
-}
-
-// InitFuncs is an array of functions that are called prior to starting the controller manager.  This is
-// necessary in instances which the controller needs to "own" objects which depend on resources to
-// pre-exist in the cluster. A common use case for this is the need to own a custom resource.
-// If the controller needs to own a custom resource type, the CRD that defines it must
-// first exist. In this case, the InitFunc will create the CRD so that the controller
-// can own custom resources of that type.  Without the InitFunc the controller will
-// crash loop because when it tries to own a non-existent resource type during manager
-// setup, it will fail.
-var InitFuncs = []func(
-	*resumesv1alpha1.Profile,
-) ([]client.Object, error){}
-
-func ConvertWorkload(component workload.Workload) (*resumesv1alpha1.Profile, error) {
-	p, ok := component.(*resumesv1alpha1.Profile)
-	if !ok {
-		return nil, resumesv1alpha1.ErrUnableToConvertProfile
+	var hubObj resumesv1beta1.Profile
+	if err := collectionObj.ConvertTo(&hubObj); err != nil {
+		return nil, fmt.Errorf("unable to convert collection to %s, %w", resumesv1beta1.GroupVersion, err)
 	}
 
-	return p, nil
+	hubObj.Default()
+
+	return &hubObj, nil
 }
@@ -0,0 +1,224 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var ErrUnableToConvertResumeVariant = errors.New("unable to convert to ResumeVariant")
+
+// ResumeVariantSpec defines the desired state of ResumeVariant.
+type ResumeVariantSpec struct {
+	// +kubebuilder:validation:Optional
+	// Specifies a reference to the collection to use for this workload.
+	// Requires the name and namespace input to find the collection.
+	// If no collection field is set, default to selecting the only
+	// workload collection in the cluster, which will result in an error
+	// if not exactly one collection is found.
+	Collection ResumeVariantCollectionSpec `json:"collection"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// (Default: the name of the ResumeVariant) The path of the variant within the resume
+	// site, e.g. "platform" serves the variant at https://<baseURL>/platform/ and its PDF at
+	// https://<baseURL>/platform/resume.pdf.
+	Path string `json:"path,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: the pageTitle of the collection) The title of the page of the variant.
+	PageTitle string `json:"pageTitle,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Selects the JobExperience members of the collection by label.  All of them are
+	// selected when no selector is set.
+	JobExperienceSelector *metav1.LabelSelector `json:"jobExperienceSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Selects the Certification members of the collection by label.  All of them are
+	// selected when no selector is set.
+	CertificationSelector *metav1.LabelSelector `json:"certificationSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Selects the Education members of the collection by label.  All of them are selected
+	// when no selector is set.
+	EducationSelector *metav1.LabelSelector `json:"educationSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Selects the Project members of the collection by label.  All of them are selected
+	// when no selector is set.
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Selects the skill families of the profile by their family name.  All of them are
+	// selected when none are listed.
+	SkillFamilies []string `json:"skillFamilies,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: the audience of the collection) The audience which the variant is tailored
+	// to.  The highlights and skills which are tagged for other audiences are left out, and
+	// those which are tagged for this audience are rendered first.
+	Audience []string `json:"audience,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Excludes individual highlights of the selected positions by their text.
+	ExcludeHighlights []string `json:"excludeHighlights,omitempty"`
+}
+
+type ResumeVariantCollectionSpec struct {
+	// +kubebuilder:validation:Required
+	// Required if specifying collection.  The name of the collection
+	// within a specific collection.namespace to reference.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "") The namespace where the collection exists.  Required only if
+	// the collection is namespace scoped and not cluster scoped.
+	Namespace string `json:"namespace"`
+}
+
+// ResumeVariantStatus defines the observed state of ResumeVariant.
+type ResumeVariantStatus struct {
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Whether the Profile collection which the ResumeVariant belongs to is found.
+	CollectionConditions []metav1.Condition `json:"collectionConditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ResumeVariant is the Schema for the resumevariants API.  A ResumeVariant selects a subset
+// of a Profile collection and its members, which is rendered as its own page and PDF.
+type ResumeVariant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ResumeVariantSpec   `json:"spec,omitempty"`
+	Status            ResumeVariantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResumeVariantList contains a list of ResumeVariant.
+type ResumeVariantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResumeVariant `json:"items"`
+}
+
+// SitePath returns the path of the variant within the resume site, which is the name of the
+// variant unless a path is set.
+func (component *ResumeVariant) SitePath() string {
+	if component.Spec.Path != "" {
+		return component.Spec.Path
+	}
+
+	return component.Name
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *ResumeVariant) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *ResumeVariant) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *ResumeVariant) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *ResumeVariant) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *ResumeVariant) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *ResumeVariant) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// SetCollectionCondition sets the condition of the collection of a component.
+func (component *ResumeVariant) SetCollectionCondition(condition metav1.Condition) {
+	meta.SetStatusCondition(&component.Status.CollectionConditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *ResumeVariant) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *ResumeVariant) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*ResumeVariant) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*ResumeVariant) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("ResumeVariant")
+}
+
+func init() {
+	SchemeBuilder.Register(&ResumeVariant{}, &ResumeVariantList{})
+}
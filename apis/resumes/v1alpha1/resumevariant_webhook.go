@@ -0,0 +1,157 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var resumevariantlog = logf.Log.WithName("resumevariant-resource")
+
+// resumeVariantReader reads the other ResumeVariants of a collection when validating the
+// path of a ResumeVariant.  It reads from the API server rather than the cache so that two
+// ResumeVariants created together cannot both claim the same path.
+var resumeVariantReader client.Reader
+
+func (r *ResumeVariant) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	resumeVariantReader = mgr.GetAPIReader()
+
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-resumes-jefedavis-dev-v1alpha1-resumevariant,mutating=false,failurePolicy=fail,sideEffects=None,groups=resumes.jefedavis.dev,resources=resumevariants,verbs=create;update,versions=v1alpha1,name=vresumevariant.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ResumeVariant{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ResumeVariant) ValidateCreate() error {
+	resumevariantlog.Info("validate create", "name", r.Name)
+
+	return r.validateResumeVariant()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *ResumeVariant) ValidateUpdate(old runtime.Object) error {
+	resumevariantlog.Info("validate update", "name", r.Name)
+
+	return r.validateResumeVariant()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *ResumeVariant) ValidateDelete() error {
+	return nil
+}
+
+// validateResumeVariant checks the collection, the selectors and the path of a
+// ResumeVariant.  A ResumeVariant is served from the site of its collection, which only reads
+// the ResumeVariants of its own namespace, so the collection must be within the namespace of
+// the ResumeVariant.  The path names the directory of the variant within the resume site, so
+// it must be unique among the ResumeVariants of the same collection.
+func (r *ResumeVariant) validateResumeVariant() error {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if r.Spec.Collection.Name != "" && r.Spec.Collection.Namespace != r.Namespace {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("collection", "namespace"), r.Spec.Collection.Namespace,
+			fmt.Sprintf("must be the namespace of the ResumeVariant, %q", r.Namespace)))
+	}
+
+	selectors := []struct {
+		name     string
+		selector *metav1.LabelSelector
+	}{
+		{"jobExperienceSelector", r.Spec.JobExperienceSelector},
+		{"certificationSelector", r.Spec.CertificationSelector},
+		{"educationSelector", r.Spec.EducationSelector},
+		{"projectSelector", r.Spec.ProjectSelector},
+	}
+
+	for _, s := range selectors {
+		if _, err := metav1.LabelSelectorAsSelector(s.selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child(s.name), s.selector, err.Error()))
+		}
+	}
+
+	duplicate, err := r.findDuplicatePath()
+	if err != nil {
+		return apierrs.NewInternalError(err)
+	}
+
+	if duplicate != nil {
+		allErrs = append(allErrs, field.Duplicate(specPath.Child("path"),
+			fmt.Sprintf("%s (already used by %s/%s)", r.SitePath(), duplicate.Namespace, duplicate.Name)))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrs.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "ResumeVariant"}, r.Name, allErrs)
+}
+
+// findDuplicatePath returns another ResumeVariant of the same collection which uses the
+// same path, or nil if there is none.  The collections are compared once they are resolved,
+// as the aliases of Certifications are.
+func (r *ResumeVariant) findDuplicatePath() (*ResumeVariant, error) {
+	if resumeVariantReader == nil {
+		return nil, nil
+	}
+
+	var resumeVariantList ResumeVariantList
+
+	if err := resumeVariantReader.List(context.TODO(), &resumeVariantList, client.InNamespace(r.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list ResumeVariants, %w", err)
+	}
+
+	resolver := &collectionResolver{reader: resumeVariantReader}
+
+	for i := range resumeVariantList.Items {
+		other := &resumeVariantList.Items[i]
+
+		if other.Name == r.Name || other.SitePath() != r.SitePath() {
+			continue
+		}
+
+		same, err := resolver.sameCollection(context.TODO(),
+			r.Spec.Collection.Name, r.Spec.Collection.Namespace,
+			other.Spec.Collection.Name, other.Spec.Collection.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		if same {
+			return other, nil
+		}
+	}
+
+	return nil, nil
+}
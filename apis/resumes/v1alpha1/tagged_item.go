@@ -0,0 +1,115 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// TaggedItem is an item of a list on a resume, such as a highlight, which may be tagged with
+// the audiences that it is relevant to.  It is written either as plain text or as an object,
+// as is the TaggedItem of v1beta1.
+// +kubebuilder:validation:Type=""
+// +kubebuilder:pruning:PreserveUnknownFields
+type TaggedItem struct {
+	// +kubebuilder:validation:Required
+	// The text of the item as it is rendered.
+	Text string `json:"text"`
+
+	// +kubebuilder:validation:Optional
+	// The audiences which the item is relevant to, e.g. "platform" or "management".
+	Tags []string `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: 0) Items with a higher priority are rendered before those with a lower one.
+	Priority int `json:"priority,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: false) A hidden item is kept on the manifest but is not rendered.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// UnmarshalJSON reads an item which is written either as plain text or as an object.
+func (item *TaggedItem) UnmarshalJSON(data []byte) error {
+	var hub v1beta1.TaggedItem
+	if err := json.Unmarshal(data, &hub); err != nil {
+		return err
+	}
+
+	*item = TaggedItem(hub)
+
+	return nil
+}
+
+// MarshalJSON writes an item with nothing but text as plain text, and any other item as an
+// object.
+func (item TaggedItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v1beta1.TaggedItem(item))
+}
+
+// String returns the text of the item, so that an item is rendered as its text.
+func (item TaggedItem) String() string {
+	return item.Text
+}
+
+// TextItems returns plain text items.
+func TextItems(texts ...string) []TaggedItem {
+	return convertItems(v1beta1.TextItems(texts...))
+}
+
+// ItemTexts returns the text of each item.
+func ItemTexts(items []TaggedItem) []string {
+	return v1beta1.ItemTexts(convertItemsTo(items))
+}
+
+// TailorItems returns the items which are rendered for an audience, in the order in which
+// they are rendered, as v1beta1.TailorItems does.
+func TailorItems(items []TaggedItem, audience []string) []TaggedItem {
+	return convertItems(v1beta1.TailorItems(convertItemsTo(items), audience))
+}
+
+// convertItemsTo converts items to the hub version (v1beta1).
+func convertItemsTo(items []TaggedItem) []v1beta1.TaggedItem {
+	if items == nil {
+		return nil
+	}
+
+	converted := make([]v1beta1.TaggedItem, 0, len(items))
+
+	for _, item := range items {
+		converted = append(converted, v1beta1.TaggedItem(item))
+	}
+
+	return converted
+}
+
+// convertItems converts items from the hub version (v1beta1).
+func convertItems(items []v1beta1.TaggedItem) []TaggedItem {
+	if items == nil {
+		return nil
+	}
+
+	converted := make([]TaggedItem, 0, len(items))
+
+	for _, item := range items {
+		converted = append(converted, TaggedItem(item))
+	}
+
+	return converted
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package variant
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+)
+
+// sampleResumeVariant is a sample containing all fields
+const sampleResumeVariant = `apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: ResumeVariant
+metadata:
+  name: resumevariant-sample
+  namespace: default
+spec:
+  #collection:
+    #name: "profile-sample"
+    #namespace: "default"
+  path: "platform"
+  pageTitle: "John Doe - Platform Engineer"
+  jobExperienceSelector:
+    matchLabels:
+      resumes.jefedavis.dev/track: "platform"
+  certificationSelector:
+    matchExpressions:
+      - key: "resumes.jefedavis.dev/track"
+        operator: "In"
+        values: ["platform", "cloud"]
+  educationSelector: {}
+  projectSelector: {}
+  skillFamilies: []
+  audience: []
+  excludeHighlights: []
+`
+
+// sampleResumeVariantRequired is a sample containing only required fields
+const sampleResumeVariantRequired = `apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: ResumeVariant
+metadata:
+  name: resumevariant-sample
+  namespace: default
+spec:
+  #collection:
+    #name: "profile-sample"
+    #namespace: "default"
+`
+
+// Sample returns the sample manifest for this custom resource.
+func Sample(requiredOnly bool) string {
+	if requiredOnly {
+		return sampleResumeVariantRequired
+	}
+
+	return sampleResumeVariant
+}
+
+// Generate returns the child resources that are associated with this workload given
+// appropriate structured inputs.  The resources are generated by the resume of the
+// collection from the members which the variant selects.
+func Generate(
+	workloadObj resumesv1alpha1.ResumeVariant,
+	collectionObj resumesv1beta1.Profile,
+	members resume.Members,
+) ([]client.Object, error) {
+	return resume.GenerateVariant(collectionObj, members, workloadObj)
+}
+
+func ConvertWorkload(component, collection workload.Workload) (
+	*resumesv1alpha1.ResumeVariant,
+	*resumesv1beta1.Profile,
+	error,
+) {
+	p, ok := component.(*resumesv1alpha1.ResumeVariant)
+	if !ok {
+		return nil, nil, resumesv1alpha1.ErrUnableToConvertResumeVariant
+	}
+
+	c, ok := collection.(*resumesv1beta1.Profile)
+	if !ok {
+		return nil, nil, resumesv1beta1.ErrUnableToConvertProfile
+	}
+
+	return p, c, nil
+}
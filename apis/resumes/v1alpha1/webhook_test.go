@@ -0,0 +1,329 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Defaulting webhooks", func() {
+	Context("Profile", func() {
+		It("defaults the images and page metadata of a minimal profile", func() {
+			profile := &v1beta1.Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-minimal", Namespace: "default"},
+				Spec: v1beta1.ProfileSpec{
+					Profile: v1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Smith"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, profile)).To(Succeed())
+
+			created := &v1beta1.Profile{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(profile), created)).To(Succeed())
+
+			Expect(created.Spec.PageTitle).To(Equal("Jane Smith - CV"))
+			Expect(created.Spec.PageCount).To(Equal(v1beta1.DefaultPageCount))
+			Expect(created.Spec.Web.Renderer).To(Equal(v1beta1.RendererNative))
+			Expect(created.Spec.Web.Theme).To(Equal(v1beta1.DefaultTheme))
+			Expect(created.Spec.Theme.Palette).To(Equal(v1beta1.DefaultPalette))
+			Expect(created.Spec.MemberDeletionPolicy).To(Equal(v1beta1.DefaultMemberDeletionPolicy))
+			Expect(created.Spec.Web.Server.Image.Name).To(Equal(v1beta1.DefaultWebServerImageName))
+			Expect(created.Spec.Web.Image.Name).To(Equal(v1beta1.DefaultWebImageName))
+			Expect(created.Spec.Web.Image.Tag).To(Equal(v1beta1.DefaultWebImageTag))
+			Expect(created.Spec.Web.Image.PullPolicy).To(Equal(v1beta1.DefaultPullPolicy))
+		})
+
+		It("keeps a page title which is set", func() {
+			profile := &v1beta1.Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-titled", Namespace: "default"},
+				Spec: v1beta1.ProfileSpec{
+					Profile:   v1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Smith"},
+					PageTitle: "Jane Smith - Resume",
+				},
+			}
+			Expect(k8sClient.Create(ctx, profile)).To(Succeed())
+
+			created := &v1beta1.Profile{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(profile), created)).To(Succeed())
+			Expect(created.Spec.PageTitle).To(Equal("Jane Smith - Resume"))
+		})
+	})
+})
+
+var _ = Describe("Validating webhooks", func() {
+	Context("Profile", func() {
+		newProfile := func(name string) *v1beta1.Profile {
+			return &v1beta1.Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: v1beta1.ProfileSpec{
+					BaseURL:   "resume.example.com",
+					PageCount: 2,
+				},
+			}
+		}
+
+		It("admits a valid profile", func() {
+			Expect(k8sClient.Create(ctx, newProfile("profile-valid"))).To(Succeed())
+		})
+
+		It("rejects a malformed baseURL", func() {
+			profile := newProfile("profile-bad-url")
+			profile.Spec.BaseURL = "https://resume.example.com/"
+
+			err := k8sClient.Create(ctx, profile)
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.baseURL"))
+		})
+
+		It("rejects a negative pageCount", func() {
+			profile := newProfile("profile-negative-pages")
+			profile.Spec.PageCount = -1
+
+			err := k8sClient.Create(ctx, profile)
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.pageCount"))
+		})
+
+		It("rejects a name which is too long to name its Service", func() {
+			err := k8sClient.Create(ctx, newProfile(strings.Repeat("a", 53)))
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("metadata.name"))
+		})
+
+		It("rejects a malformed memberSelector", func() {
+			profile := newProfile("profile-bad-selector")
+			profile.Spec.MemberSelector = &v1beta1.ProfileSpecMemberSelector{
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "resume", Operator: metav1.LabelSelectorOpIn},
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, profile)
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.memberSelector.selector"))
+		})
+
+		It("rejects a non-numeric v1alpha1 pageCount", func() {
+			profile := &Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-bad-pages", Namespace: "default"},
+				Spec:       ProfileSpec{PageCount: "two"},
+			}
+
+			err := k8sClient.Create(ctx, profile)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("pageCount"))
+		})
+	})
+
+	Context("JobExperience", func() {
+		newJobExperience := func(name string, positions ...JobExperienceSpecPosition) *JobExperience {
+			return &JobExperience{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: JobExperienceSpec{
+					Employer:  "Employer",
+					StartDate: "2015-03",
+					EndDate:   "2019-06-30",
+					Positions: positions,
+				},
+			}
+		}
+
+		It("admits positions within the employer dates", func() {
+			Expect(k8sClient.Create(ctx, newJobExperience("experience-valid",
+				JobExperienceSpecPosition{Title: "Engineer", StartDate: "2015-03-16", EndDate: "2017-01"},
+				JobExperienceSpecPosition{Title: "Senior Engineer", StartDate: "2017-01", EndDate: "2019-06"},
+			))).To(Succeed())
+		})
+
+		It("rejects a position which starts before the employer", func() {
+			err := k8sClient.Create(ctx, newJobExperience("experience-early",
+				JobExperienceSpecPosition{Title: "Engineer", StartDate: "2014-12", EndDate: "2017-01"},
+			))
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.positions[0].startDate"))
+		})
+
+		It("rejects a position which is ongoing after the employer ended", func() {
+			err := k8sClient.Create(ctx, newJobExperience("experience-late",
+				JobExperienceSpecPosition{Title: "Engineer", StartDate: "2017-01", EndDate: "present"},
+			))
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.positions[0].endDate"))
+		})
+	})
+
+	Context("Certification", func() {
+		newCertification := func(name, alias string) *Certification {
+			return &Certification{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: CertificationSpec{
+					Collection: CertificationCollectionSpec{Name: "profile-valid", Namespace: "default"},
+					Title:      "Certification",
+					EarnedDate: "2020-01-02",
+					Alias:      alias,
+				},
+			}
+		}
+
+		It("rejects an empty alias", func() {
+			err := k8sClient.Create(ctx, newCertification("cert-no-alias", ""))
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.alias"))
+		})
+
+		It("rejects a duplicate alias within a collection", func() {
+			Expect(k8sClient.Create(ctx, newCertification("cert-first", "cka"))).To(Succeed())
+
+			err := k8sClient.Create(ctx, newCertification("cert-second", "cka"))
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.alias"))
+		})
+
+		It("admits the same alias in another collection", func() {
+			certification := newCertification("cert-other-collection", "cka")
+			certification.Spec.Collection.Name = "profile-other"
+
+			Expect(k8sClient.Create(ctx, certification)).To(Succeed())
+		})
+
+		It("admits the same alias for certifications which belong to no collection", func() {
+			// among several collections, a certification without a collection belongs to none
+			Expect(k8sClient.Create(ctx, &v1beta1.Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-second", Namespace: "default"},
+				Spec:       v1beta1.ProfileSpec{BaseURL: "second.example.com"},
+			})).To(Succeed())
+
+			for _, name := range []string{"cert-no-collection", "cert-no-collection-again"} {
+				certification := newCertification(name, "ckad")
+				certification.Spec.Collection = CertificationCollectionSpec{}
+
+				Expect(k8sClient.Create(ctx, certification)).To(Succeed())
+			}
+		})
+	})
+
+	Context("ResumeVariant", func() {
+		newResumeVariant := func(name, path string) *ResumeVariant {
+			return &ResumeVariant{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: ResumeVariantSpec{
+					Collection: ResumeVariantCollectionSpec{Name: "profile-valid", Namespace: "default"},
+					Path:       path,
+				},
+			}
+		}
+
+		It("rejects an invalid selector", func() {
+			variant := newResumeVariant("variant-bad-selector", "")
+			variant.Spec.JobExperienceSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "track", Operator: "Near"}},
+			}
+
+			err := k8sClient.Create(ctx, variant)
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.jobExperienceSelector"))
+		})
+
+		It("rejects a collection in another namespace", func() {
+			variant := newResumeVariant("variant-other-namespace", "")
+			variant.Spec.Collection.Namespace = "kube-public"
+
+			err := k8sClient.Create(ctx, variant)
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.collection.namespace"))
+		})
+
+		It("rejects a duplicate path within a collection", func() {
+			Expect(k8sClient.Create(ctx, newResumeVariant("platform", ""))).To(Succeed())
+
+			err := k8sClient.Create(ctx, newResumeVariant("variant-platform", "platform"))
+			Expect(apierrs.IsInvalid(err)).To(BeTrue(), "expected invalid error, got %v", err)
+			Expect(err.Error()).To(ContainSubstring("spec.path"))
+		})
+
+		It("admits the same path in another collection", func() {
+			variant := newResumeVariant("variant-other-collection", "platform")
+			variant.Spec.Collection.Name = "profile-other"
+
+			Expect(k8sClient.Create(ctx, variant)).To(Succeed())
+		})
+	})
+})
+
+var _ = Describe("Conversion webhook", func() {
+	Context("Profile", func() {
+		It("reads a v1alpha1 profile as v1beta1", func() {
+			profile := &Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-alpha", Namespace: "default"},
+				Spec: ProfileSpec{
+					PageCount: "3",
+					Profile: ProfileSpecProfile{
+						FirstName: "Jane",
+						Projects:  []string{"https://github.com/jane/project"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, profile)).To(Succeed())
+
+			converted := &v1beta1.Profile{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(profile), converted)).To(Succeed())
+
+			Expect(converted.Spec.PageCount).To(Equal(3))
+			Expect(converted.Spec.Profile.FirstName).To(Equal("Jane"))
+			Expect(converted.Spec.Profile.Projects).To(Equal([]v1beta1.ProfileSpecProject{
+				{URL: "https://github.com/jane/project"},
+			}))
+		})
+
+		It("keeps v1beta1 only fields through a v1alpha1 update", func() {
+			profile := &v1beta1.Profile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-beta", Namespace: "default"},
+				Spec: v1beta1.ProfileSpec{
+					Profile: v1beta1.ProfileSpecProfile{
+						Projects: []v1beta1.ProfileSpecProject{
+							{URL: "https://github.com/jane/project", Title: "Project", Description: "A project"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, profile)).To(Succeed())
+
+			alpha := &Profile{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(profile), alpha)).To(Succeed())
+			Expect(alpha.Spec.Profile.Projects).To(Equal([]string{"https://github.com/jane/project"}))
+
+			alpha.Spec.PageTitle = "Updated"
+			Expect(k8sClient.Update(ctx, alpha)).To(Succeed())
+
+			updated := &v1beta1.Profile{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(profile), updated)).To(Succeed())
+
+			Expect(updated.Spec.PageTitle).To(Equal("Updated"))
+			Expect(updated.Spec.Profile.Projects).To(Equal(profile.Spec.Profile.Projects))
+			Expect(updated.Annotations).NotTo(HaveKey(ProfileSpecAnnotation))
+		})
+	})
+})
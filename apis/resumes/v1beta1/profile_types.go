@@ -0,0 +1,574 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"errors"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var ErrUnableToConvertProfile = errors.New("unable to convert to Profile")
+
+// Renderers which may build the resume site of a Profile.
+const (
+	RendererNative = "native"
+	RendererHugo   = "hugo"
+)
+
+// Types of the readiness conditions of a Profile, one for each of the workloads which serve
+// its resume.
+const (
+	ConditionDeploymentAvailable = "DeploymentAvailable"
+	ConditionServiceEndpoints    = "ServiceEndpointsReady"
+	ConditionIngressAddress      = "IngressAddressAssigned"
+	ConditionCertificateReady    = "CertificateReady"
+)
+
+// ConditionPdfFits is the type of the render condition of a Profile which reports whether its
+// resume fits the pageCount of the PDF, or is cut off at the end of the last page.
+const ConditionPdfFits = "PdfFits"
+
+// Policies for the rendered output of the collection members of a Profile which is deleted.
+const (
+	MemberDeletionPolicyCascade = "Cascade"
+	MemberDeletionPolicyOrphan  = "Orphan"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// ProfileSpec defines the desired state of Profile.
+type ProfileSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// +kubebuilder:validation:Optional
+	Profile ProfileSpecProfile `json:"profile,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Web ProfileSpecWeb `json:"web,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Colors and fonts of the resume site.
+	Theme ProfileSpecTheme `json:"theme,omitempty"`
+
+	// +kubebuilder:default="example.com"
+	// +kubebuilder:validation:Optional
+	// (Default: "example.com")
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: "<profile.firstName> <profile.lastName> - CV")
+	PageTitle string `json:"pageTitle,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// The audience which the resume is tailored to.  When set, the highlights and skills
+	// which are tagged for other audiences are left out, and those which are tagged for this
+	// audience are rendered first.
+	Audience []string `json:"audience,omitempty"`
+
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Optional
+	// (Default: 1)
+	PageCount int `json:"pageCount,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Deprecated: the PDF is rendered by the operator and the converter image is no longer
+	// deployed.  The field is kept so that existing manifests remain valid.
+	Pdf ProfileSpecPdf `json:"pdf,omitempty"`
+
+	// +kubebuilder:default="letsencrypt-staging"
+	// +kubebuilder:validation:Optional
+	// (Default: "letsencrypt-staging")
+	CertIssuer string `json:"certIssuer,omitempty"`
+
+	// +kubebuilder:default="nginx"
+	// +kubebuilder:validation:Optional
+	// (Default: "nginx")
+	IngressClass string `json:"ingressClass,omitempty"`
+
+	// +kubebuilder:default="Cascade"
+	// +kubebuilder:validation:Enum=Cascade;Orphan
+	// +kubebuilder:validation:Optional
+	// (Default: "Cascade")
+	// What happens to the output which is rendered for the collection members when the
+	// Profile is deleted.  Cascade deletes the ConfigMaps of the members and of their
+	// ResumeVariants, while Orphan leaves them in place.  The members themselves are kept.
+	MemberDeletionPolicy string `json:"memberDeletionPolicy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// JobExperiences and Certifications which are adopted as members of the Profile, along
+	// with those which reference it, so that several Profiles can share one set of members.
+	MemberSelector *ProfileSpecMemberSelector `json:"memberSelector,omitempty"`
+}
+
+// SharedMembersLabel is set to "true" on a namespace whose JobExperiences and Certifications
+// may be adopted by the Profiles of other namespaces.  Without it, the members of a namespace
+// are only adopted by the Profiles within it, so that a Profile cannot publish the members of
+// a namespace which has not agreed to share them.
+const SharedMembersLabel = "resumes.jefedavis.dev/shared-members"
+
+// ProfileSpecMemberSelector selects the JobExperiences and Certifications which a Profile
+// adopts as its members by their labels and by the labels of their namespace.
+type ProfileSpecMemberSelector struct {
+	// +kubebuilder:validation:Optional
+	// Namespaces which the members are adopted from.  Only the namespace of the Profile is
+	// selected when it is unset, and every namespace when it is empty.  Namespaces other than
+	// that of the Profile are only selected when they are labeled with
+	// resumes.jefedavis.dev/shared-members: "true".
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Labels of the members which are adopted.  No member is adopted when it is unset, and
+	// every member within the selected namespaces when it is empty.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+type ProfileSpecProfile struct {
+	// +kubebuilder:default="John"
+	// +kubebuilder:validation:Optional
+	// (Default: "John")
+	FirstName string `json:"firstName,omitempty"`
+
+	// +kubebuilder:default="Doe"
+	// +kubebuilder:validation:Optional
+	// (Default: "Doe")
+	LastName string `json:"lastName,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Email string `json:"email,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	LinkedinURL string `json:"linkedinURL,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	GithubURL string `json:"githubURL,omitempty"`
+
+	// +kubebuilder:default="South Carolina"
+	// +kubebuilder:validation:Optional
+	// (Default: "South Carolina")
+	Location string `json:"location,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Overview string `json:"overview,omitempty"`
+
+	// +kubebuilder:default={}
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	CoreCompetencies []string `json:"coreCompetencies,omitempty"`
+
+	// +kubebuilder:default={}
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	// Projects which are listed inline on the profile.  Project resources which belong
+	// to this collection are rendered alongside them.
+	Projects []ProfileSpecProject `json:"projects,omitempty"`
+
+	// +kubebuilder:default={}
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Skills []ProfileSpecSkillFamily `json:"skills,omitempty"`
+}
+
+type ProfileSpecProject struct {
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Title string `json:"title,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Description string `json:"description,omitempty"`
+}
+
+type ProfileSpecSkillFamily struct {
+	Family string       `json:"family,omitempty"`
+	Items  []TaggedItem `json:"items,omitempty"`
+}
+
+type ProfileSpecWeb struct {
+	// +kubebuilder:default="native"
+	// +kubebuilder:validation:Enum=native;hugo
+	// +kubebuilder:validation:Optional
+	// (Default: "native")
+	// Renderer which builds the resume site.  The native renderer renders the page within the
+	// operator and publishes it behind a static file server, while the hugo renderer runs a
+	// Hugo server which renders the page within the cluster.
+	Renderer string `json:"renderer,omitempty"`
+
+	// +kubebuilder:default="classic"
+	// +kubebuilder:validation:Enum=classic;compact
+	// +kubebuilder:validation:Optional
+	// (Default: "classic")
+	// Theme which the native renderer renders the page with.
+	Theme string `json:"theme,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: false) Serves the resume as a single column DOCX at /resume.docx, alongside
+	// the PDF, for applicant tracking systems which cannot read the layout of the PDF.
+	Docx bool `json:"docx,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Image of the Hugo server which is run by the hugo renderer.
+	Image ProfileSpecWebImage `json:"image,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Server which serves the page rendered by the native renderer.
+	Server ProfileSpecWebServer `json:"server,omitempty"`
+}
+
+type ProfileSpecWebServer struct {
+	// +kubebuilder:validation:Optional
+	Image ProfileSpecWebServerImage `json:"image,omitempty"`
+}
+
+type ProfileSpecWebServerImage struct {
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Registry string `json:"registry,omitempty"`
+
+	// +kubebuilder:default="busybox"
+	// +kubebuilder:validation:Optional
+	// (Default: "busybox")
+	Name string `json:"name,omitempty"`
+
+	// +kubebuilder:default="1.35"
+	// +kubebuilder:validation:Optional
+	// (Default: "1.35")
+	Tag string `json:"tag,omitempty"`
+
+	// +kubebuilder:default="IfNotPresent"
+	// +kubebuilder:validation:Optional
+	// (Default: "IfNotPresent")
+	PullPolicy string `json:"pullPolicy,omitempty"`
+}
+
+type ProfileSpecWebImage struct {
+	// +kubebuilder:validation:Optional
+	// (Default: the version of the operator)
+	Tag string `json:"tag,omitempty"`
+
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Optional
+	// (Default: "")
+	Registry string `json:"registry,omitempty"`
+
+	// +kubebuilder:default="jefedavis/resume"
+	// +kubebuilder:validation:Optional
+	// (Default: "jefedavis/resume")
+	Name string `json:"name,omitempty"`
+
+	// +kubebuilder:default="IfNotPresent"
+	// +kubebuilder:validation:Optional
+	// (Default: "IfNotPresent")
+	PullPolicy string `json:"pullPolicy,omitempty"`
+}
+
+type ProfileSpecTheme struct {
+	// +kubebuilder:default="green"
+	// +kubebuilder:validation:Enum=green;blue;slate;burgundy
+	// +kubebuilder:validation:Optional
+	// (Default: "green")
+	// Named palette which the colors of the site are taken from.
+	Palette string `json:"palette,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Colors which override those of the palette.
+	Colors ProfileSpecThemeColors `json:"colors,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Fonts ProfileSpecThemeFonts `json:"fonts,omitempty"`
+}
+
+// ProfileSpecThemeColors are the colors of the resume site, each a hex color such as
+// "#4C7535".  A color which is unset is taken from the palette.
+type ProfileSpecThemeColors struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Light string `json:"light,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Dark string `json:"dark,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	PageBackground string `json:"pageBackground,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Primary string `json:"primary,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Secondary string `json:"secondary,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Header string `json:"header,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	Header2 string `json:"header2,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	IconPrimary string `json:"iconPrimary,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	IconBackground string `json:"iconBackground,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	RightColumnBackground string `json:"rightColumnBackground,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	RightColumnHeadingText string `json:"rightColumnHeadingText,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	RightColumnBodyText string `json:"rightColumnBodyText,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	RightColumnIconPrimary string `json:"rightColumnIconPrimary,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`
+	RightColumnIconBackground string `json:"rightColumnIconBackground,omitempty"`
+}
+
+// ProfileSpecThemeFonts are the font families of the resume site, each a list of families
+// in the form of a CSS font-family, e.g. "Lato, Helvetica, sans-serif".  A font which is unset
+// is left to the theme.
+type ProfileSpecThemeFonts struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[-A-Za-z0-9 ,'"]*$`
+	// Font of the text of the page.
+	Body string `json:"body,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[-A-Za-z0-9 ,'"]*$`
+	// Font of the name and the headings of the page.
+	Heading string `json:"heading,omitempty"`
+}
+
+type ProfileSpecPdf struct {
+	// +kubebuilder:validation:Optional
+	Image ProfileSpecPdfImage `json:"image,omitempty"`
+}
+
+type ProfileSpecPdfImage struct {
+	// +kubebuilder:validation:Optional
+	Registry string `json:"registry,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Tag string `json:"tag,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PullPolicy string `json:"pullPolicy,omitempty"`
+}
+
+// ProfileStatus defines the observed state of Profile.
+type ProfileStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	Created               bool                     `json:"created,omitempty"`
+	DependenciesSatisfied bool                     `json:"dependenciesSatisfied,omitempty"`
+	Conditions            []*status.PhaseCondition `json:"conditions,omitempty"`
+	Resources             []*status.ChildResource  `json:"resources,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Readiness of each of the workloads which serve the resume, by the type of condition.
+	ReadinessConditions []metav1.Condition `json:"readinessConditions,omitempty"`
+
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	// Conditions of the last render of the resume, such as whether it fits the pages of the PDF.
+	RenderConditions []metav1.Condition `json:"renderConditions,omitempty"`
+
+	// +optional
+	// URL at which the resume site is served.
+	URL string `json:"url,omitempty"`
+
+	// +optional
+	// URL at which the PDF of the resume is downloaded.
+	PdfURL string `json:"pdfURL,omitempty"`
+
+	// +optional
+	// Number of JobExperiences which are rendered into the resume.
+	JobExperienceCount int32 `json:"jobExperienceCount"`
+
+	// +optional
+	// Number of Certifications which are rendered into the resume.
+	CertificationCount int32 `json:"certificationCount"`
+
+	// +optional
+	// Members of the collection which are rendered into the resume, including those which are
+	// adopted from other namespaces by the memberSelector.
+	Members []corev1.ObjectReference `json:"members,omitempty"`
+
+	// +optional
+	// Generation of the Profile which was last rendered.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	// Time at which the rendered resume last changed.
+	LastRenderTime *metav1.Time `json:"lastRenderTime,omitempty"`
+
+	// +optional
+	// Checksum of the resources which were last rendered for the resume.
+	LastRenderHash string `json:"lastRenderHash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`
+// +kubebuilder:printcolumn:name="PDF",type=string,JSONPath=`.status.pdfURL`,priority=1
+// +kubebuilder:printcolumn:name="Experiences",type=integer,JSONPath=`.status.jobExperienceCount`
+// +kubebuilder:printcolumn:name="Certifications",type=integer,JSONPath=`.status.certificationCount`
+// +kubebuilder:printcolumn:name="Generation",type=integer,JSONPath=`.status.observedGeneration`,priority=1
+// +kubebuilder:printcolumn:name="Last Render",type=date,JSONPath=`.status.lastRenderTime`
+// +kubebuilder:printcolumn:name="Hash",type=string,JSONPath=`.status.lastRenderHash`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:storageversion
+
+// Profile is the Schema for the profiles API.
+type Profile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ProfileSpec   `json:"spec,omitempty"`
+	Status            ProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProfileList contains a list of Profile.
+type ProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Profile `json:"items"`
+}
+
+// interface methods
+
+// GetReadyStatus returns the ready status for a component.
+func (component *Profile) GetReadyStatus() bool {
+	return component.Status.Created
+}
+
+// SetReadyStatus sets the ready status for a component.
+func (component *Profile) SetReadyStatus(ready bool) {
+	component.Status.Created = ready
+}
+
+// GetDependencyStatus returns the dependency status for a component.
+func (component *Profile) GetDependencyStatus() bool {
+	return component.Status.DependenciesSatisfied
+}
+
+// SetDependencyStatus sets the dependency status for a component.
+func (component *Profile) SetDependencyStatus(dependencyStatus bool) {
+	component.Status.DependenciesSatisfied = dependencyStatus
+}
+
+// GetPhaseConditions returns the phase conditions for a component.
+func (component *Profile) GetPhaseConditions() []*status.PhaseCondition {
+	return component.Status.Conditions
+}
+
+// SetPhaseCondition sets the phase conditions for a component.
+func (component *Profile) SetPhaseCondition(condition *status.PhaseCondition) {
+	for i, currentCondition := range component.GetPhaseConditions() {
+		if currentCondition.Phase == condition.Phase {
+			component.Status.Conditions[i] = condition
+
+			return
+		}
+	}
+
+	// phase not found, lets add it to the list.
+	component.Status.Conditions = append(component.Status.Conditions, condition)
+}
+
+// GetResources returns the child resource status for a component.
+func (component *Profile) GetChildResourceConditions() []*status.ChildResource {
+	return component.Status.Resources
+}
+
+// SetResources sets the phase conditions for a component.
+func (component *Profile) SetChildResourceCondition(resource *status.ChildResource) {
+	for i, currentResource := range component.GetChildResourceConditions() {
+		if currentResource.Group == resource.Group && currentResource.Version == resource.Version && currentResource.Kind == resource.Kind {
+			if currentResource.Name == resource.Name && currentResource.Namespace == resource.Namespace {
+				component.Status.Resources[i] = resource
+
+				return
+			}
+		}
+	}
+
+	// phase not found, lets add it to the collection
+	component.Status.Resources = append(component.Status.Resources, resource)
+}
+
+// GetDependencies returns the dependencies for a component.
+func (*Profile) GetDependencies() []workload.Workload {
+	return []workload.Workload{}
+}
+
+// GetComponentGVK returns a GVK object for the component.
+func (*Profile) GetWorkloadGVK() schema.GroupVersionKind {
+	return GroupVersion.WithKind("Profile")
+}
+
+func init() {
+	SchemeBuilder.Register(&Profile{}, &ProfileList{})
+}
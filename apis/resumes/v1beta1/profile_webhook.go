@@ -0,0 +1,173 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strings"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Defaults which are filled by the defaulting webhook.  The image tags are pinned to the
+// release of the images which this version of the operator is built against.  An empty
+// registry pulls the images from Docker Hub.
+const (
+	DefaultRenderer             = RendererNative
+	DefaultTheme                = "classic"
+	DefaultPalette              = "green"
+	DefaultWebImageName         = "jefedavis/resume"
+	DefaultWebServerImageName   = "busybox"
+	DefaultWebServerImageTag    = "1.35"
+	DefaultPullPolicy           = "IfNotPresent"
+	DefaultPageCount            = 1
+	DefaultMemberDeletionPolicy = MemberDeletionPolicyCascade
+	defaultPageTitleTail        = "CV"
+)
+
+// DefaultWebImageTag is the default tag of the web image, which is the version of the
+// operator.  It is set at build time from the same version as resumectl reports, and is not
+// defaulted by the CRD, so that the default cannot drift from the release.
+var DefaultWebImageTag = "v0.1.0"
+
+// serviceNameSuffix is appended to the name of a Profile to name the Service of its resume.
+const serviceNameSuffix = "-resume-svc"
+
+// log is for logging in this package.
+var profilelog = logf.Log.WithName("profile-resource")
+
+func (r *Profile) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-resumes-jefedavis-dev-v1beta1-profile,mutating=true,failurePolicy=fail,sideEffects=None,groups=resumes.jefedavis.dev,resources=profiles,verbs=create;update,versions=v1beta1,name=mprofile.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Profile{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.  It
+// fills the image settings and the page metadata which are left unset, so that a Profile with
+// only its required fields deploys.
+func (r *Profile) Default() {
+	profilelog.Info("default", "name", r.Name)
+
+	setDefault(&r.Spec.Web.Renderer, DefaultRenderer)
+	setDefault(&r.Spec.Web.Theme, DefaultTheme)
+	setDefault(&r.Spec.Theme.Palette, DefaultPalette)
+
+	setDefault(&r.Spec.Web.Image.Name, DefaultWebImageName)
+	setDefault(&r.Spec.Web.Image.Tag, DefaultWebImageTag)
+	setDefault(&r.Spec.Web.Image.PullPolicy, DefaultPullPolicy)
+
+	setDefault(&r.Spec.Web.Server.Image.Name, DefaultWebServerImageName)
+	setDefault(&r.Spec.Web.Server.Image.Tag, DefaultWebServerImageTag)
+	setDefault(&r.Spec.Web.Server.Image.PullPolicy, DefaultPullPolicy)
+
+	setDefault(&r.Spec.PageTitle, r.defaultPageTitle())
+	setDefault(&r.Spec.MemberDeletionPolicy, DefaultMemberDeletionPolicy)
+
+	if r.Spec.PageCount == 0 {
+		r.Spec.PageCount = DefaultPageCount
+	}
+}
+
+// defaultPageTitle returns the page title derived from the name on the profile.
+func (r *Profile) defaultPageTitle() string {
+	name := strings.TrimSpace(r.Spec.Profile.FirstName + " " + r.Spec.Profile.LastName)
+	if name == "" {
+		return defaultPageTitleTail
+	}
+
+	return name + " - " + defaultPageTitleTail
+}
+
+// setDefault sets field to value if the field is unset.
+func setDefault(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-resumes-jefedavis-dev-v1beta1-profile,mutating=false,failurePolicy=fail,sideEffects=None,groups=resumes.jefedavis.dev,resources=profiles,verbs=create;update,versions=v1beta1,name=vprofile.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Profile{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *Profile) ValidateCreate() error {
+	profilelog.Info("validate create", "name", r.Name)
+
+	return r.validateProfile()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *Profile) ValidateUpdate(old runtime.Object) error {
+	profilelog.Info("validate update", "name", r.Name)
+
+	return r.validateProfile()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *Profile) ValidateDelete() error {
+	return nil
+}
+
+// validateProfile checks the name and the fields which are rendered into the site
+// configuration and the ingress, as a mistake in any of them only shows up once the resume
+// is deployed.
+func (r *Profile) validateProfile() error {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	// the name of the profile prefixes the names of its child resources, the longest of which
+	// is its Service, which must be a DNS-1035 label
+	for _, msg := range validation.IsDNS1035Label(r.Name + serviceNameSuffix) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), r.Name, msg))
+	}
+
+	// the base URL is used as the ingress host and as the host of the site URL
+	if r.Spec.BaseURL != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(r.Spec.BaseURL) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("baseURL"), r.Spec.BaseURL, msg))
+		}
+	}
+
+	if r.Spec.PageCount < 1 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("pageCount"), r.Spec.PageCount, "must be a positive whole number"))
+	}
+
+	if r.Spec.MemberSelector != nil {
+		selectorPath := specPath.Child("memberSelector")
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(r.Spec.MemberSelector.NamespaceSelector, selectorPath.Child("namespaceSelector"))...)
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(r.Spec.MemberSelector.Selector, selectorPath.Child("selector"))...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrs.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "Profile"}, r.Name, allErrs)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/yaml"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/certification"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/education"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/experience"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/project"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the data file tests")
+
+// adversarial returns a value for a field which is not valid YAML when it is written into a
+// data file as it is: it holds a mapping, a quote of either kind, a comment, a newline, a
+// list item, an anchor and an alias.
+func adversarial(field string) string {
+	return field + `: "quoted" 'single' # not a comment` + "\n- not: a list &anchor *alias"
+}
+
+// stringValues returns each of the strings within a decoded YAML document.
+func stringValues(value interface{}) []string {
+	values := []string{}
+
+	switch value := value.(type) {
+	case string:
+		values = append(values, value)
+	case []interface{}:
+		for _, item := range value {
+			values = append(values, stringValues(item)...)
+		}
+	case map[string]interface{}:
+		for _, item := range value {
+			values = append(values, stringValues(item)...)
+		}
+	}
+
+	return values
+}
+
+var _ = Describe("Data files", func() {
+	// expectData compares a data file with its golden file in testdata, and expects each of the
+	// values to be read back from it unchanged.
+	expectData := func(golden, data string, err error, values ...string) {
+		Expect(err).NotTo(HaveOccurred())
+
+		path := filepath.Join("testdata", golden)
+
+		if *updateGolden {
+			Expect(os.WriteFile(path, []byte(data), 0o600)).To(Succeed())
+		}
+
+		expected, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(string(expected)))
+
+		var decoded interface{}
+		Expect(yaml.Unmarshal([]byte(data), &decoded)).To(Succeed())
+		Expect(stringValues(decoded)).To(ContainElements(values))
+	}
+
+	It("should write the profile from any values", func() {
+		parent := &resumesv1beta1.Profile{
+			Spec: resumesv1beta1.ProfileSpec{
+				Audience: []string{"platform"},
+				Profile: resumesv1beta1.ProfileSpecProfile{
+					FirstName:        adversarial("firstName"),
+					LastName:         adversarial("lastName"),
+					PhoneNumber:      adversarial("phoneNumber"),
+					Email:            adversarial("email"),
+					LinkedinURL:      adversarial("linkedinURL"),
+					GithubURL:        adversarial("githubURL"),
+					Location:         adversarial("location"),
+					Overview:         adversarial("overview"),
+					CoreCompetencies: []string{adversarial("coreCompetencies"), "yes", "null"},
+					Projects:         []resumesv1beta1.ProfileSpecProject{{URL: adversarial("projects")}},
+					Skills: []resumesv1beta1.ProfileSpecSkillFamily{
+						{
+							Family: adversarial("family"),
+							Items: []resumesv1beta1.TaggedItem{
+								{Text: adversarial("items")},
+								{Text: "management only", Tags: []string{"management"}},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		data, err := profileYAML(parent)
+		expectData("profile.yaml", data, err,
+			adversarial("firstName"), adversarial("lastName"), adversarial("phoneNumber"),
+			adversarial("email"), adversarial("linkedinURL"), adversarial("githubURL"),
+			adversarial("location"), adversarial("overview"), adversarial("coreCompetencies"),
+			"yes", "null", adversarial("projects"), adversarial("family"), adversarial("items"))
+		Expect(data).NotTo(ContainSubstring("management only"))
+	})
+
+	It("should write a job experience from any values", func() {
+		parent := &resumesv1alpha1.JobExperience{
+			Spec: resumesv1alpha1.JobExperienceSpec{
+				Employer:  adversarial("employer"),
+				Location:  adversarial("location"),
+				StartDate: "2019-03",
+				EndDate:   resumesv1alpha1.Date(adversarial("endDate")),
+				Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+					{
+						Title:     adversarial("title"),
+						StartDate: "2019-03-04",
+						EndDate:   resumesv1alpha1.DatePresent,
+						Highlights: []resumesv1alpha1.TaggedItem{
+							{Text: adversarial("highlights")},
+							{Text: "hidden", Hidden: true},
+						},
+					},
+				},
+			},
+		}
+
+		data, err := experience.Data(parent, &resumesv1alpha1.Profile{})
+		expectData("experience.yaml", data, err,
+			adversarial("employer"), adversarial("location"), "2019-03", "2019-03-04",
+			adversarial("endDate"), adversarial("title"), adversarial("highlights"))
+		Expect(data).NotTo(ContainSubstring("hidden"))
+	})
+
+	It("should write a certification from any values", func() {
+		parent := &resumesv1alpha1.Certification{
+			Spec: resumesv1alpha1.CertificationSpec{
+				Title:         adversarial("title"),
+				Issuer:        adversarial("issuer"),
+				EarnedDate:    "2021-06",
+				Alias:         adversarial("alias"),
+				ValidationURL: adversarial("validationURL"),
+				ImageURL:      adversarial("imageURL"),
+			},
+		}
+
+		data, err := certification.Data(parent)
+		expectData("certification.yaml", data, err,
+			adversarial("title"), adversarial("issuer"), "2021-06", adversarial("alias"),
+			adversarial("validationURL"), adversarial("imageURL"))
+	})
+
+	It("should write an education from any values", func() {
+		parent := &resumesv1alpha1.Education{
+			Spec: resumesv1alpha1.EducationSpec{
+				School:       adversarial("school"),
+				Degree:       adversarial("degree"),
+				FieldOfStudy: adversarial("fieldOfStudy"),
+				Location:     adversarial("location"),
+				StartDate:    "2010-09",
+				EndDate:      "2014-05",
+				GPA:          "3.9",
+				Honors:       []string{adversarial("honors")},
+				Coursework:   []string{adversarial("coursework")},
+			},
+		}
+
+		data, err := education.Data(parent)
+		expectData("education.yaml", data, err,
+			adversarial("school"), adversarial("degree"), adversarial("fieldOfStudy"),
+			adversarial("location"), "2010-09", "2014-05", "3.9", adversarial("honors"),
+			adversarial("coursework"))
+	})
+
+	It("should write a project from any values", func() {
+		parent := &resumesv1alpha1.Project{
+			Spec: resumesv1alpha1.ProjectSpec{
+				Title:       adversarial("title"),
+				Description: adversarial("description"),
+				Role:        adversarial("role"),
+				TechStack:   []string{adversarial("techStack"), "C++", "#go"},
+				StartDate:   "2022",
+				RepoURL:     adversarial("repoURL"),
+				DemoURL:     adversarial("demoURL"),
+			},
+		}
+
+		data, err := project.Data(parent)
+		expectData("project.yaml", data, err,
+			adversarial("title"), adversarial("description"), adversarial("role"),
+			adversarial("techStack"), "C++", "#go", "2022", adversarial("repoURL"),
+			adversarial("demoURL"))
+	})
+})
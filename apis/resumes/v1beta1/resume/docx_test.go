@@ -0,0 +1,136 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+var _ = Describe("DOCX", func() {
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+	)
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				PageTitle: "Jane Doe - CV",
+				Profile: resumesv1beta1.ProfileSpecProfile{
+					FirstName: "Jane",
+					LastName:  "Doe",
+					Email:     "jane@example.com",
+					Skills: []resumesv1beta1.ProfileSpecSkillFamily{
+						{Family: "Languages", Items: resumesv1beta1.TextItems("Go", "C++")},
+					},
+				},
+				Web: resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererNative, Docx: true},
+			},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{
+					Spec: resumesv1alpha1.JobExperienceSpec{
+						Employer:  "Acme & Sons",
+						StartDate: "2020-01",
+						Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+							{Title: "SRE", Highlights: resumesv1alpha1.TextItems("Cut costs by <50%>")},
+						},
+					},
+				},
+			},
+			Certifications: []resumesv1alpha1.Certification{
+				{Spec: resumesv1alpha1.CertificationSpec{Title: "CKA", Issuer: "CNCF"}},
+			},
+		}
+	})
+
+	// volumeMounts returns the volume mounts of the container of a Deployment.  The fields are
+	// read directly, as the unstructured helpers cannot copy the int fields of a container.
+	volumeMounts := func(resources []client.Object) []interface{} {
+		deployment := resources[0].(*unstructured.Unstructured).Object
+		template := deployment["spec"].(map[string]interface{})["template"].(map[string]interface{})
+		containers := template["spec"].(map[string]interface{})["containers"].([]interface{})
+
+		return containers[0].(map[string]interface{})["volumeMounts"].([]interface{})
+	}
+
+	It("should create the resume-docx ConfigMap only when the DOCX is served", func() {
+		resources, err := CreateConfigMapResumeDocx(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(1))
+		Expect(resources[0].GetName()).To(Equal("jane-resume-docx"))
+		Expect(resources[0].GetNamespace()).To(Equal("resumes"))
+
+		encoded, _, _ := unstructured.NestedString(resources[0].(*unstructured.Unstructured).Object, "binaryData", "resume.docx")
+		docx, err := base64.StdEncoding.DecodeString(encoded)
+		Expect(err).NotTo(HaveOccurred())
+
+		expected, err := render.DOCX(Resume(parent, members))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(docx).To(Equal(expected))
+
+		parent.Spec.Web.Docx = false
+
+		resources, err = CreateConfigMapResumeDocx(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(BeEmpty())
+	})
+
+	It("should serve the DOCX from the site of either renderer", func() {
+		resources, err := CreateDeploymentResumeServer(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		volumes, _, _ := unstructured.NestedSlice(resources[0].(*unstructured.Unstructured).Object, "spec", "template", "spec", "volumes")
+		sources, _, _ := unstructured.NestedSlice(volumes[0].(map[string]interface{}), "projected", "sources")
+		Expect(sources).To(ContainElement(configMapSource("jane-resume-docx")))
+
+		parent.Spec.Web.Renderer = resumesv1beta1.RendererHugo
+
+		resources, err = CreateDeploymentResume(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(volumeMounts(resources)).To(ContainElement(HaveKeyWithValue("mountPath", "/site/static/resume.docx")))
+
+		// the pods start before the ConfigMap of a newly served DOCX is created
+		volumes, _, _ = unstructured.NestedSlice(resources[0].(*unstructured.Unstructured).Object, "spec", "template", "spec", "volumes")
+		Expect(volumes).To(ContainElement(HaveKeyWithValue("configMap", map[string]interface{}{
+			"name":     "jane-resume-docx",
+			"optional": true,
+		})))
+
+		parent.Spec.Web.Docx = false
+
+		resources, err = CreateDeploymentResume(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(volumeMounts(resources)).NotTo(ContainElement(HaveKeyWithValue("mountPath", "/site/static/resume.docx")))
+	})
+})
@@ -0,0 +1,121 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// CollectionIndex is the field by which the members of a Profile collection are indexed in
+// the cache of the controller manager.  Its value is the namespace and name of the collection
+// which a member references, or empty for a member which does not reference a collection.
+const CollectionIndex = "spec.collection"
+
+// CollectionIndexValue returns the value of the CollectionIndex of a member which references
+// the collection with the given name and namespace.
+func CollectionIndexValue(name, namespace string) string {
+	if name == "" {
+		return ""
+	}
+
+	return namespace + "/" + name
+}
+
+// IndexMembers indexes each kind of member of a Profile collection by the collection which it
+// references, so that the members of a collection are listed from the cache without reading
+// every member in its namespace.
+func IndexMembers(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &resumesv1alpha1.JobExperience{}, CollectionIndex, func(object client.Object) []string {
+		member, ok := object.(*resumesv1alpha1.JobExperience)
+		if !ok {
+			return nil
+		}
+
+		return []string{CollectionIndexValue(member.Spec.Collection.Name, member.Spec.Collection.Namespace)}
+	}); err != nil {
+		return fmt.Errorf("unable to index JobExperience members, %w", err)
+	}
+
+	if err := indexer.IndexField(ctx, &resumesv1alpha1.Certification{}, CollectionIndex, func(object client.Object) []string {
+		member, ok := object.(*resumesv1alpha1.Certification)
+		if !ok {
+			return nil
+		}
+
+		return []string{CollectionIndexValue(member.Spec.Collection.Name, member.Spec.Collection.Namespace)}
+	}); err != nil {
+		return fmt.Errorf("unable to index Certification members, %w", err)
+	}
+
+	if err := indexer.IndexField(ctx, &resumesv1alpha1.Education{}, CollectionIndex, func(object client.Object) []string {
+		member, ok := object.(*resumesv1alpha1.Education)
+		if !ok {
+			return nil
+		}
+
+		return []string{CollectionIndexValue(member.Spec.Collection.Name, member.Spec.Collection.Namespace)}
+	}); err != nil {
+		return fmt.Errorf("unable to index Education members, %w", err)
+	}
+
+	if err := indexer.IndexField(ctx, &resumesv1alpha1.Project{}, CollectionIndex, func(object client.Object) []string {
+		member, ok := object.(*resumesv1alpha1.Project)
+		if !ok {
+			return nil
+		}
+
+		return []string{CollectionIndexValue(member.Spec.Collection.Name, member.Spec.Collection.Namespace)}
+	}); err != nil {
+		return fmt.Errorf("unable to index Project members, %w", err)
+	}
+
+	if err := indexer.IndexField(ctx, &resumesv1alpha1.ResumeVariant{}, CollectionIndex, func(object client.Object) []string {
+		member, ok := object.(*resumesv1alpha1.ResumeVariant)
+		if !ok {
+			return nil
+		}
+
+		return []string{CollectionIndexValue(member.Spec.Collection.Name, member.Spec.Collection.Namespace)}
+	}); err != nil {
+		return fmt.Errorf("unable to index ResumeVariant members, %w", err)
+	}
+
+	return nil
+}
+
+// ListIndexedMembers returns the components which belong to the collection, as ListMembers
+// does, from a reader which is backed by a cache that the members are indexed in by
+// IndexMembers.  Only the members which reference the collection, and those which reference
+// no collection, are read.
+func ListIndexedMembers(ctx context.Context, reader client.Reader, collection *resumesv1beta1.Profile) (*Members, error) {
+	return listMembers(ctx, reader, collection,
+		[]client.ListOption{
+			client.InNamespace(collection.Namespace),
+			client.MatchingFields{CollectionIndex: CollectionIndexValue(collection.Name, collection.Namespace)},
+		},
+		[]client.ListOption{
+			client.InNamespace(collection.Namespace),
+			client.MatchingFields{CollectionIndex: ""},
+		},
+	)
+}
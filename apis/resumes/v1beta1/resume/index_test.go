@@ -0,0 +1,62 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+)
+
+// recordingIndexer records the index functions which are registered with it, by the type of
+// object which is indexed.
+type recordingIndexer map[string]client.IndexerFunc
+
+func (indexer recordingIndexer) IndexField(_ context.Context, object client.Object, field string, extract client.IndexerFunc) error {
+	Expect(field).To(Equal(CollectionIndex))
+
+	indexer[fmt.Sprintf("%T", object)] = extract
+
+	return nil
+}
+
+var _ = Describe("Index", func() {
+	It("should index each kind of member by the collection which it references", func() {
+		indexer := recordingIndexer{}
+		Expect(IndexMembers(context.Background(), indexer)).To(Succeed())
+
+		jobExperience := &resumesv1alpha1.JobExperience{ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "resumes"}}
+		jobExperience.Spec.Collection.Name = "jane"
+		jobExperience.Spec.Collection.Namespace = "resumes"
+
+		certification := &resumesv1alpha1.Certification{ObjectMeta: metav1.ObjectMeta{Name: "cka", Namespace: "resumes"}}
+
+		Expect(indexer).To(HaveLen(5))
+		Expect(indexer["*v1alpha1.JobExperience"](jobExperience)).To(Equal([]string{"resumes/jane"}))
+		Expect(indexer["*v1alpha1.Certification"](certification)).To(Equal([]string{""}))
+
+		// an object of another kind is not indexed
+		Expect(indexer["*v1alpha1.Education"](certification)).To(BeNil())
+	})
+})
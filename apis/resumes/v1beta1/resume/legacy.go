@@ -0,0 +1,123 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// legacyResource returns a resource with only its kind, name and namespace set, which
+// identifies a child resource that is no longer generated for a Profile.
+func legacyResource(parent *resumesv1beta1.Profile, apiVersion, kind, name string) client.Object {
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	return resourceObj
+}
+
+// LegacyResources returns the child resources which earlier versions of the operator created
+// for a Profile and which are no longer generated, so that they are not left running once
+// the operator is upgraded.  The pdf-converter ran a privileged headless browser to print the
+// PDF, which is now rendered in-process.
+//
+// The other child resources had fixed names before they were named after their Profile.  The
+// Ingress of the fixed name claims the same host and path as the Ingress which replaces it,
+// which ingress controllers may refuse to admit.  The cert-manager Certificate of the fixed
+// name is owned by that Ingress, and is garbage collected along with it, but its resume-tls
+// Secret is created by cert-manager rather than the Profile.  The Secret is left behind unless
+// cert-manager runs with --enable-certificate-owner-ref, and is not deleted here.
+//
+// The resume-docx ConfigMap is also returned once the DOCX is no longer served, as it is only
+// generated while web.docx is set.
+func LegacyResources(parent *resumesv1beta1.Profile) []client.Object {
+	resourceObjs := []client.Object{
+		legacyResource(parent, "apps/v1", "Deployment", "pdf-converter"),
+		legacyResource(parent, "v1", "Service", "pdf-converter-svc"),
+	}
+
+	// controlled by field: web.docx
+	if !parent.Spec.Web.Docx {
+		resourceObjs = append(resourceObjs, legacyResource(parent, "v1", "ConfigMap", ResourceName(parent, "resume-docx")))
+	}
+
+	// a Profile without a name generates the resources of the fixed names
+	if parent.Name == "" {
+		return resourceObjs
+	}
+
+	for _, name := range []string{
+		"resume-config",
+		"resume-profile",
+		"resume-projects",
+		"resume-pdf",
+		"resume-docx",
+		"resume-site",
+	} {
+		resourceObjs = append(resourceObjs, legacyResource(parent, "v1", "ConfigMap", name))
+	}
+
+	return append(resourceObjs,
+		legacyResource(parent, "apps/v1", "Deployment", "resume"),
+		legacyResource(parent, "v1", "Service", "resume-svc"),
+		legacyResource(parent, "networking.k8s.io/v1", "Ingress", "resume"),
+	)
+}
+
+// DeleteLegacyResources deletes the legacy resources of a Profile.  Only the resources which
+// are controlled by the Profile are deleted, as a resource of the same name may belong to
+// something else within the namespace.
+func DeleteLegacyResources(ctx context.Context, c client.Client, parent *resumesv1beta1.Profile) error {
+	for _, legacy := range LegacyResources(parent) {
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(legacy.GetObjectKind().GroupVersionKind())
+
+		if err := c.Get(ctx, client.ObjectKeyFromObject(legacy), existing); err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("unable to get legacy %s %s, %w", existing.GetKind(), legacy.GetName(), err)
+		}
+
+		if !metav1.IsControlledBy(existing, parent) {
+			continue
+		}
+
+		if err := c.Delete(ctx, existing); err != nil && !apierrs.IsNotFound(err) {
+			return fmt.Errorf("unable to delete legacy %s %s, %w", existing.GetKind(), legacy.GetName(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Legacy", func() {
+	var parent *resumesv1beta1.Profile
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			TypeMeta:   metav1.TypeMeta{APIVersion: resumesv1beta1.GroupVersion.String(), Kind: "Profile"},
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes", UID: types.UID("jane-uid")},
+		}
+	})
+
+	// controlledBy returns the owner references of a child resource which is controlled by
+	// the Profile with the given name and uid.
+	controlledBy := func(name string, uid types.UID) []metav1.OwnerReference {
+		controller := true
+
+		return []metav1.OwnerReference{
+			{
+				APIVersion: resumesv1beta1.GroupVersion.String(),
+				Kind:       "Profile",
+				Name:       name,
+				UID:        uid,
+				Controller: &controller,
+			},
+		}
+	}
+
+	// exists returns whether an object with the given name exists in the namespace of the
+	// Profile.
+	exists := func(c client.Client, object client.Object, name string) bool {
+		err := c.Get(context.Background(), client.ObjectKey{Name: name, Namespace: "resumes"}, object)
+		if apierrs.IsNotFound(err) {
+			return false
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+
+		return true
+	}
+
+	It("should delete the pdf-converter of an upgraded Profile", func() {
+		c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name: "pdf-converter", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+				Name: "pdf-converter-svc", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+		).Build()
+
+		Expect(DeleteLegacyResources(context.Background(), c, parent)).To(Succeed())
+
+		Expect(exists(c, &appsv1.Deployment{}, "pdf-converter")).To(BeFalse())
+		Expect(exists(c, &corev1.Service{}, "pdf-converter-svc")).To(BeFalse())
+
+		// the legacy resources are gone already on the next reconcile
+		Expect(DeleteLegacyResources(context.Background(), c, parent)).To(Succeed())
+	})
+
+	It("should delete the children of an upgraded Profile which had fixed names", func() {
+		c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name: "resume", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+			&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+				Name: "resume", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name: "resume-config", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name: "jane-resume-config", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+		).Build()
+
+		Expect(DeleteLegacyResources(context.Background(), c, parent)).To(Succeed())
+
+		Expect(exists(c, &appsv1.Deployment{}, "resume")).To(BeFalse())
+		Expect(exists(c, &networkingv1.Ingress{}, "resume")).To(BeFalse())
+		Expect(exists(c, &corev1.ConfigMap{}, "resume-config")).To(BeFalse())
+
+		// the children which are named after the Profile are kept
+		Expect(exists(c, &corev1.ConfigMap{}, "jane-resume-config")).To(BeTrue())
+	})
+
+	It("should delete the resume-docx ConfigMap once the DOCX is no longer served", func() {
+		c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name: "jane-resume-docx", Namespace: "resumes", OwnerReferences: controlledBy("jane", "jane-uid"),
+			}},
+		).Build()
+
+		parent.Spec.Web.Docx = true
+		Expect(DeleteLegacyResources(context.Background(), c, parent)).To(Succeed())
+		Expect(exists(c, &corev1.ConfigMap{}, "jane-resume-docx")).To(BeTrue())
+
+		parent.Spec.Web.Docx = false
+		Expect(DeleteLegacyResources(context.Background(), c, parent)).To(Succeed())
+		Expect(exists(c, &corev1.ConfigMap{}, "jane-resume-docx")).To(BeFalse())
+	})
+
+	It("should keep the legacy resources which the Profile does not control", func() {
+		c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name: "pdf-converter", Namespace: "resumes", OwnerReferences: controlledBy("john", "john-uid"),
+			}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "pdf-converter-svc", Namespace: "resumes"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+				Name: "resume-svc", Namespace: "resumes", OwnerReferences: controlledBy("john", "john-uid"),
+			}},
+		).Build()
+
+		Expect(DeleteLegacyResources(context.Background(), c, parent)).To(Succeed())
+
+		Expect(exists(c, &appsv1.Deployment{}, "pdf-converter")).To(BeTrue())
+		Expect(exists(c, &corev1.Service{}, "pdf-converter-svc")).To(BeTrue())
+		Expect(exists(c, &corev1.Service{}, "resume-svc")).To(BeTrue())
+	})
+})
@@ -0,0 +1,487 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/certification"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/education"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/experience"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/project"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// Members are the components which belong to a Profile collection.  The rendered data of
+// each member is projected into the resume site alongside the data of the Profile.
+type Members struct {
+	JobExperiences []resumesv1alpha1.JobExperience
+	Certifications []resumesv1alpha1.Certification
+	Educations     []resumesv1alpha1.Education
+	Projects       []resumesv1alpha1.Project
+	Variants       []resumesv1alpha1.ResumeVariant
+
+	// Adopted holds the JobExperiences and Certifications which are adopted by the
+	// memberSelector of the collection rather than reference it, by AdoptedKey.  Their data
+	// is rendered by the collection itself, as they may belong to other namespaces and
+	// collections.
+	Adopted map[string]bool
+}
+
+// GetCollection returns the Profile collection with the given name and namespace.  When no
+// name is given, the only collection in the cluster is returned, as it is for a component
+// which does not reference a specific collection.
+func GetCollection(ctx context.Context, reader client.Reader, name, namespace string) (*resumesv1beta1.Profile, error) {
+	var collectionList resumesv1beta1.ProfileList
+
+	if err := reader.List(ctx, &collectionList); err != nil {
+		return nil, fmt.Errorf("unable to list collection Profile, %w", err)
+	}
+
+	// if a specific collection has not been requested, we ensure only one exists
+	if name == "" {
+		if len(collectionList.Items) != 1 {
+			return nil, fmt.Errorf("expected only 1 Profile collection, found %v, %w", len(collectionList.Items), workload.ErrCollectionNotFound)
+		}
+
+		return &collectionList.Items[0], nil
+	}
+
+	// find the collection that was requested and return it
+	for i := range collectionList.Items {
+		if collectionList.Items[i].Name == name && collectionList.Items[i].Namespace == namespace {
+			return &collectionList.Items[i], nil
+		}
+	}
+
+	return nil, workload.ErrCollectionNotFound
+}
+
+// ListMembers returns the components which belong to the collection.  Only components within
+// the namespace of the collection are returned, as their rendered data is projected into the
+// resume site from within that namespace.
+func ListMembers(ctx context.Context, reader client.Reader, collection *resumesv1beta1.Profile) (*Members, error) {
+	return listMembers(ctx, reader, collection, []client.ListOption{client.InNamespace(collection.Namespace)})
+}
+
+// listMembers returns the components which belong to the collection from those which are
+// listed with each of the given sets of list options.
+func listMembers(
+	ctx context.Context,
+	reader client.Reader,
+	collection *resumesv1beta1.Profile,
+	listOptions ...[]client.ListOption,
+) (*Members, error) {
+	var collectionList resumesv1beta1.ProfileList
+
+	if err := reader.List(ctx, &collectionList); err != nil {
+		return nil, fmt.Errorf("unable to list collection Profile, %w", err)
+	}
+
+	onlyCollection := len(collectionList.Items) == 1
+
+	var (
+		jobExperienceList resumesv1alpha1.JobExperienceList
+		certificationList resumesv1alpha1.CertificationList
+		educationList     resumesv1alpha1.EducationList
+		projectList       resumesv1alpha1.ProjectList
+		resumeVariantList resumesv1alpha1.ResumeVariantList
+	)
+
+	for _, options := range listOptions {
+		var jobExperiences resumesv1alpha1.JobExperienceList
+
+		if err := reader.List(ctx, &jobExperiences, options...); err != nil {
+			return nil, fmt.Errorf("unable to list JobExperience members, %w", err)
+		}
+
+		jobExperienceList.Items = append(jobExperienceList.Items, jobExperiences.Items...)
+
+		var certifications resumesv1alpha1.CertificationList
+
+		if err := reader.List(ctx, &certifications, options...); err != nil {
+			return nil, fmt.Errorf("unable to list Certification members, %w", err)
+		}
+
+		certificationList.Items = append(certificationList.Items, certifications.Items...)
+
+		var educations resumesv1alpha1.EducationList
+
+		if err := reader.List(ctx, &educations, options...); err != nil {
+			return nil, fmt.Errorf("unable to list Education members, %w", err)
+		}
+
+		educationList.Items = append(educationList.Items, educations.Items...)
+
+		var projects resumesv1alpha1.ProjectList
+
+		if err := reader.List(ctx, &projects, options...); err != nil {
+			return nil, fmt.Errorf("unable to list Project members, %w", err)
+		}
+
+		projectList.Items = append(projectList.Items, projects.Items...)
+
+		var resumeVariants resumesv1alpha1.ResumeVariantList
+
+		if err := reader.List(ctx, &resumeVariants, options...); err != nil {
+			return nil, fmt.Errorf("unable to list ResumeVariant members, %w", err)
+		}
+
+		resumeVariantList.Items = append(resumeVariantList.Items, resumeVariants.Items...)
+	}
+
+	members := &Members{}
+
+	for _, member := range jobExperienceList.Items {
+		if !member.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if IsCollectionMember(collection, member.Spec.Collection.Name, member.Spec.Collection.Namespace, onlyCollection) {
+			members.JobExperiences = append(members.JobExperiences, member)
+		}
+	}
+
+	for _, member := range certificationList.Items {
+		if !member.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if IsCollectionMember(collection, member.Spec.Collection.Name, member.Spec.Collection.Namespace, onlyCollection) {
+			members.Certifications = append(members.Certifications, member)
+		}
+	}
+
+	for _, member := range educationList.Items {
+		if !member.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if IsCollectionMember(collection, member.Spec.Collection.Name, member.Spec.Collection.Namespace, onlyCollection) {
+			members.Educations = append(members.Educations, member)
+		}
+	}
+
+	for _, member := range projectList.Items {
+		if !member.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if IsCollectionMember(collection, member.Spec.Collection.Name, member.Spec.Collection.Namespace, onlyCollection) {
+			members.Projects = append(members.Projects, member)
+		}
+	}
+
+	for _, member := range resumeVariantList.Items {
+		if !member.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if IsCollectionMember(collection, member.Spec.Collection.Name, member.Spec.Collection.Namespace, onlyCollection) {
+			members.Variants = append(members.Variants, member)
+		}
+	}
+
+	if err := adoptMembers(ctx, reader, collection, members); err != nil {
+		return nil, err
+	}
+
+	members.Sort()
+
+	return members, nil
+}
+
+// IsCollectionMember determines if a component which references a collection by name and
+// namespace belongs to the given collection.  A component which does not reference a
+// specific collection belongs to the only collection in the cluster.
+func IsCollectionMember(collection *resumesv1beta1.Profile, name, namespace string, onlyCollection bool) bool {
+	if name == "" {
+		return onlyCollection
+	}
+
+	return collection.Name == name && collection.Namespace == namespace
+}
+
+// Sort orders the members by name, so that the resources generated from them do not change
+// between reconciliation loops.  Adopted members which share a name are ordered by namespace.
+func (members *Members) Sort() {
+	sort.Slice(members.JobExperiences, func(i, j int) bool {
+		return nameLess(&members.JobExperiences[i], &members.JobExperiences[j])
+	})
+
+	sort.Slice(members.Certifications, func(i, j int) bool {
+		return nameLess(&members.Certifications[i], &members.Certifications[j])
+	})
+
+	sort.Slice(members.Educations, func(i, j int) bool {
+		return members.Educations[i].Name < members.Educations[j].Name
+	})
+
+	sort.Slice(members.Projects, func(i, j int) bool {
+		return members.Projects[i].Name < members.Projects[j].Name
+	})
+
+	sort.Slice(members.Variants, func(i, j int) bool {
+		return members.Variants[i].Name < members.Variants[j].Name
+	})
+}
+
+// nameLess orders two members by name, and then by namespace.
+func nameLess(a, b metav1.Object) bool {
+	if a.GetName() != b.GetName() {
+		return a.GetName() < b.GetName()
+	}
+
+	return a.GetNamespace() < b.GetNamespace()
+}
+
+// MemberResources returns the child resources which are rendered for the members of a
+// collection rather than for the collection itself: the ConfigMap of each member, and the
+// resources of each ResumeVariant.  Only the kind, name and namespace of the ConfigMap of a
+// member are set, as it is rendered by the controller of the member.  The ConfigMaps of
+// adopted members are left out, as they are rendered for the collections which the members
+// belong to.
+func MemberResources(parent *resumesv1beta1.Profile, members *Members) ([]client.Object, error) {
+	resourceObjects := []client.Object{}
+
+	for i := range members.JobExperiences {
+		if members.IsAdopted(KindJobExperience, &members.JobExperiences[i]) {
+			continue
+		}
+
+		resourceObjects = append(resourceObjects, memberConfigMap(experience.ConfigMapName(&members.JobExperiences[i]), members.JobExperiences[i].Namespace))
+	}
+
+	for i := range members.Certifications {
+		if members.IsAdopted(KindCertification, &members.Certifications[i]) {
+			continue
+		}
+
+		resourceObjects = append(resourceObjects, memberConfigMap(certification.ConfigMapName(&members.Certifications[i]), members.Certifications[i].Namespace))
+	}
+
+	for i := range members.Educations {
+		resourceObjects = append(resourceObjects, memberConfigMap(education.ConfigMapName(&members.Educations[i]), members.Educations[i].Namespace))
+	}
+
+	for i := range members.Projects {
+		resourceObjects = append(resourceObjects, memberConfigMap(project.ConfigMapName(&members.Projects[i]), members.Projects[i].Namespace))
+	}
+
+	for _, variant := range members.Variants {
+		variantObjects, err := GenerateVariant(*parent, *members, variant)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjects = append(resourceObjects, variantObjects...)
+	}
+
+	return resourceObjects, nil
+}
+
+// memberConfigMap returns a ConfigMap with only its name and namespace set.
+func memberConfigMap(name, namespace string) client.Object {
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName(name)
+	configMap.SetNamespace(namespace)
+
+	return configMap
+}
+
+// AggregateConfigMaps returns the ConfigMaps of a collection and of its ResumeVariants which
+// hold the data of all of the members, such as the page and PDF of the resume, as they are
+// rendered from the given members.
+func AggregateConfigMaps(parent *resumesv1beta1.Profile, members *Members) ([]client.Object, error) {
+	resourceObjects, err := Generate(*parent, *members)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, variant := range members.Variants {
+		variantObjects, err := GenerateVariant(*parent, *members, variant)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjects = append(resourceObjects, variantObjects...)
+	}
+
+	configMaps := []client.Object{}
+
+	for _, resourceObject := range resourceObjects {
+		if resourceObject.GetObjectKind().GroupVersionKind().Kind == "ConfigMap" {
+			configMaps = append(configMaps, resourceObject)
+		}
+	}
+
+	return configMaps, nil
+}
+
+// experienceSources returns the projected volume sources for the rendered data of each
+// JobExperience which belongs to the collection.
+func experienceSources(parent *resumesv1beta1.Profile, members *Members) []interface{} {
+	sources := []interface{}{}
+
+	for i := range members.JobExperiences {
+		name := experience.ConfigMapName(&members.JobExperiences[i])
+
+		if members.IsAdopted(KindJobExperience, &members.JobExperiences[i]) {
+			name = AdoptedConfigMapName(parent, name, &members.JobExperiences[i])
+		}
+
+		sources = append(sources, configMapSource(name))
+	}
+
+	return sources
+}
+
+// certificationSources returns the projected volume sources for the rendered data of each
+// Certification which belongs to the collection.
+func certificationSources(parent *resumesv1beta1.Profile, members *Members) []interface{} {
+	sources := []interface{}{}
+
+	for i := range members.Certifications {
+		name := certification.ConfigMapName(&members.Certifications[i])
+
+		if members.IsAdopted(KindCertification, &members.Certifications[i]) {
+			name = AdoptedConfigMapName(parent, name, &members.Certifications[i])
+		}
+
+		sources = append(sources, configMapSource(name))
+	}
+
+	return sources
+}
+
+// educationSources returns the projected volume sources for the rendered data of each
+// Education which belongs to the collection.
+func educationSources(members *Members) []interface{} {
+	sources := []interface{}{}
+
+	for i := range members.Educations {
+		sources = append(sources, configMapSource(education.ConfigMapName(&members.Educations[i])))
+	}
+
+	return sources
+}
+
+// projectSources returns the projected volume sources for the rendered data of each
+// Project which belongs to the collection, along with the projects which are rendered
+// from the deprecated profile.projects field of the collection.
+func projectSources(parent *resumesv1beta1.Profile, members *Members) []interface{} {
+	sources := []interface{}{configMapSource(ResourceName(parent, "resume-projects"))}
+
+	for i := range members.Projects {
+		sources = append(sources, configMapSource(project.ConfigMapName(&members.Projects[i])))
+	}
+
+	return sources
+}
+
+// variantSources returns the projected volume sources for the page and PDF of each
+// ResumeVariant which belongs to the collection, each within the path of the variant.
+func variantSources(parent *resumesv1beta1.Profile, members *Members) []interface{} {
+	sources := []interface{}{}
+
+	for i := range members.Variants {
+		sources = append(sources, variantItemSources(parent, &members.Variants[i], members.Variants[i].SitePath()+"/")...)
+	}
+
+	return sources
+}
+
+// variantItemSources returns the projected volume sources for the page and PDF of a
+// ResumeVariant, with the given prefix on the path of each.
+func variantItemSources(parent *resumesv1beta1.Profile, variant *resumesv1alpha1.ResumeVariant, prefix string) []interface{} {
+	return []interface{}{
+		configMapItemSource(VariantResourceName(variant, ResourceName(parent, "resume-site")), "index.html", prefix+"index.html"),
+		configMapItemSource(VariantResourceName(variant, ResourceName(parent, "resume-pdf")), "resume.pdf", prefix+"resume.pdf"),
+	}
+}
+
+// variantVolumes returns a projected volume for the page and PDF of each ResumeVariant which
+// belongs to the collection, for the hugo renderer.  Each volume is mounted as a directory
+// rather than by subPath, so that the kubelet updates a variant in a running pod.
+func variantVolumes(parent *resumesv1beta1.Profile, members *Members) []interface{} {
+	volumes := []interface{}{}
+
+	for i := range members.Variants {
+		volumes = append(volumes, map[string]interface{}{
+			"name": fmt.Sprintf("variant-%d", i),
+			"projected": map[string]interface{}{
+				"sources": variantItemSources(parent, &members.Variants[i], ""),
+			},
+		})
+	}
+
+	return volumes
+}
+
+// variantVolumeMounts returns the mounts of the volumes of variantVolumes, each at the path
+// of its ResumeVariant within the static files of the hugo site.
+func variantVolumeMounts(members *Members) []interface{} {
+	mounts := []interface{}{}
+
+	for i := range members.Variants {
+		mounts = append(mounts, map[string]interface{}{
+			"mountPath": "/site/static/" + members.Variants[i].SitePath(),
+			"name":      fmt.Sprintf("variant-%d", i),
+			"readOnly":  true,
+		})
+	}
+
+	return mounts
+}
+
+// configMapItemSource returns a projected volume source for a single key of a ConfigMap at
+// the given path.  The source is optional, as is the source of every member.
+func configMapItemSource(name, key, path string) map[string]interface{} {
+	return map[string]interface{}{
+		"configMap": map[string]interface{}{
+			"name":     name,
+			"optional": true,
+			"items": []interface{}{
+				map[string]interface{}{
+					"key":  key,
+					"path": path,
+				},
+			},
+		},
+	}
+}
+
+// configMapSource returns a projected volume source for a ConfigMap.  The source is optional
+// so that the resume site may start before a newly added member has rendered its data.
+func configMapSource(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"configMap": map[string]interface{}{
+			"name":     name,
+			"optional": true,
+		},
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/experience"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Members", func() {
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+	)
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				PageCount: 1,
+				Profile:   resumesv1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Doe"},
+				Web:       resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererNative},
+			},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "resumes"},
+					Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Acme"},
+				},
+			},
+			Certifications: []resumesv1alpha1.Certification{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "cka", Namespace: "resumes"},
+					Spec:       resumesv1alpha1.CertificationSpec{Title: "CKA"},
+				},
+			},
+			Variants: []resumesv1alpha1.ResumeVariant{
+				{ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "resumes"}},
+			},
+		}
+	})
+
+	// kindNames returns the kind and name of each resource.
+	kindNames := func(resources []client.Object) []string {
+		names := []string{}
+		for _, resource := range resources {
+			names = append(names, resource.GetObjectKind().GroupVersionKind().Kind+"/"+resource.GetName())
+		}
+
+		return names
+	}
+
+	It("should return the output which is rendered for the members", func() {
+		resources, err := MemberResources(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(kindNames(resources)).To(ConsistOf(
+			"ConfigMap/resume-experience-acme",
+			"ConfigMap/resume-cert-cka",
+			"ConfigMap/jane-resume-pdf-platform",
+			"ConfigMap/jane-resume-site-platform",
+		))
+
+		for _, resource := range resources {
+			Expect(resource.GetNamespace()).To(Equal("resumes"))
+		}
+	})
+
+	It("should key the data of JobExperiences with the same employer apart", func() {
+		members.JobExperiences = append(members.JobExperiences, resumesv1alpha1.JobExperience{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme-internship", Namespace: "resumes"},
+			Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Acme"},
+		})
+
+		keys := []string{}
+
+		for i := range members.JobExperiences {
+			resources, err := experience.CreateConfigMapResumeExperience(&members.JobExperiences[i], &resumesv1alpha1.Profile{})
+			Expect(err).NotTo(HaveOccurred())
+
+			data, _, _ := unstructured.NestedStringMap(resources[0].(*unstructured.Unstructured).Object, "data")
+			for key := range data {
+				keys = append(keys, key)
+			}
+		}
+
+		// the ConfigMaps of the members are projected into the same directory of the site
+		Expect(keys).To(Equal([]string{"acme.yaml", "acme-internship.yaml"}))
+	})
+
+	It("should render the aggregate ConfigMaps without a member which is removed", func() {
+		page := func(resources []client.Object) string {
+			for _, resource := range resources {
+				if resource.GetName() == "jane-resume-site" {
+					html, _, _ := unstructured.NestedString(resource.(*unstructured.Unstructured).Object, "data", "index.html")
+
+					return html
+				}
+			}
+
+			return ""
+		}
+
+		configMaps, err := AggregateConfigMaps(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, configMap := range configMaps {
+			Expect(configMap.GetObjectKind().GroupVersionKind().Kind).To(Equal("ConfigMap"))
+		}
+
+		Expect(kindNames(configMaps)).To(ContainElements("ConfigMap/jane-resume-pdf-platform", "ConfigMap/jane-resume-site-platform"))
+		Expect(page(configMaps)).To(ContainSubstring("Acme"))
+
+		members.JobExperiences = nil
+
+		configMaps, err = AggregateConfigMaps(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(page(configMaps)).NotTo(ContainSubstring("Acme"))
+	})
+
+	It("should get the collection which is named, or the only collection", func() {
+		scheme := runtime.NewScheme()
+		Expect(resumesv1beta1.AddToScheme(scheme)).To(Succeed())
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(parent).Build()
+
+		collection, err := GetCollection(context.Background(), c, "", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(collection.Name).To(Equal("jane"))
+
+		_, err = GetCollection(context.Background(), c, "jane", "other")
+		Expect(err).To(MatchError(workload.ErrCollectionNotFound))
+
+		Expect(c.Create(context.Background(), &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "john", Namespace: "resumes"},
+		})).To(Succeed())
+
+		collection, err = GetCollection(context.Background(), c, "jane", "resumes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(collection.Name).To(Equal("jane"))
+
+		// a component which names no collection cannot choose between them
+		_, err = GetCollection(context.Background(), c, "", "")
+		Expect(err).To(MatchError(workload.ErrCollectionNotFound))
+	})
+})
@@ -0,0 +1,42 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// ResourceName returns the name of the child resource of a Profile which is generated as the
+// resource with the given name.  The name of the Profile is prefixed to it, so that the child
+// resources of several Profiles within one namespace do not collide.  A Profile without a
+// name, as it is read from a manifest by the CLI, leaves the name unchanged.
+func ResourceName(parent *resumesv1beta1.Profile, name string) string {
+	if parent.Name == "" {
+		return name
+	}
+
+	return parent.Name + "-" + name
+}
+
+// InstanceLabel returns the value of the app.kubernetes.io/instance label of the child
+// resources of a Profile, which the Deployments and Service of its resume select their pods
+// by.  It is derived from the name of the Profile rather than the name of the person, so that
+// the pods of several Profiles for the same person within one namespace are never selected
+// together.
+func InstanceLabel(parent *resumesv1beta1.Profile) string {
+	return ResourceName(parent, "resume")
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Names", func() {
+	newProfile := func(name string, renderer string) *resumesv1beta1.Profile {
+		return &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				BaseURL:   name + ".example.com",
+				PageCount: 1,
+				Profile:   resumesv1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Doe"},
+				Web:       resumesv1beta1.ProfileSpecWeb{Renderer: renderer, Docx: true},
+			},
+		}
+	}
+
+	// names returns the kind and name of each child resource of a Profile.
+	names := func(parent *resumesv1beta1.Profile) []string {
+		resources, err := Generate(*parent, Members{})
+		Expect(err).NotTo(HaveOccurred())
+
+		kindNames := []string{}
+		for _, resource := range resources {
+			kindNames = append(kindNames, resource.GetObjectKind().GroupVersionKind().Kind+"/"+resource.GetName())
+		}
+
+		return kindNames
+	}
+
+	It("should name the child resources of two Profiles in one namespace apart", func() {
+		for _, renderer := range []string{resumesv1beta1.RendererNative, resumesv1beta1.RendererHugo} {
+			jane := names(newProfile("jane", renderer))
+			john := names(newProfile("john", renderer))
+
+			Expect(jane).NotTo(BeEmpty())
+
+			for _, name := range jane {
+				Expect(john).NotTo(ContainElement(name))
+			}
+		}
+	})
+
+	It("should select the pods of two Profiles for the same person apart", func() {
+		// podSelection returns the selectors of the child resources of a Profile, along with
+		// the labels of the pods of its Deployments.
+		podSelection := func(parent *resumesv1beta1.Profile) ([]labels.Selector, []labels.Set) {
+			resources, err := Generate(*parent, Members{})
+			Expect(err).NotTo(HaveOccurred())
+
+			selectors, podLabels := []labels.Selector{}, []labels.Set{}
+
+			for _, resource := range resources {
+				object := resource.(*unstructured.Unstructured).Object
+
+				switch resource.GetObjectKind().GroupVersionKind().Kind {
+				case "Service":
+					selector, _, _ := unstructured.NestedStringMap(object, "spec", "selector")
+					selectors = append(selectors, labels.SelectorFromSet(selector))
+				case "Deployment":
+					selector, _, _ := unstructured.NestedStringMap(object, "spec", "selector", "matchLabels")
+					selectors = append(selectors, labels.SelectorFromSet(selector))
+
+					template, _, _ := unstructured.NestedStringMap(object, "spec", "template", "metadata", "labels")
+					podLabels = append(podLabels, template)
+				}
+			}
+
+			Expect(selectors).NotTo(BeEmpty())
+			Expect(podLabels).NotTo(BeEmpty())
+
+			return selectors, podLabels
+		}
+
+		for _, renderer := range []string{resumesv1beta1.RendererNative, resumesv1beta1.RendererHugo} {
+			janeSelectors, janePods := podSelection(newProfile("jane", renderer))
+			teamSelectors, teamPods := podSelection(newProfile("team", renderer))
+
+			for _, selector := range janeSelectors {
+				for _, pod := range janePods {
+					Expect(selector.Matches(pod)).To(BeTrue())
+				}
+
+				for _, pod := range teamPods {
+					Expect(selector.Matches(pod)).To(BeFalse())
+				}
+			}
+
+			for _, selector := range teamSelectors {
+				for _, pod := range janePods {
+					Expect(selector.Matches(pod)).To(BeFalse())
+				}
+			}
+		}
+	})
+
+	It("should route the Ingress to the Service of its Profile", func() {
+		parent := newProfile("jane", resumesv1beta1.RendererNative)
+
+		resources, err := CreateIngressResume(parent, &Members{})
+		Expect(err).NotTo(HaveOccurred())
+
+		ingress := resources[0].(*unstructured.Unstructured).Object
+		Expect(resources[0].GetName()).To(Equal("jane-resume"))
+
+		tls, _, _ := unstructured.NestedSlice(ingress, "spec", "tls")
+		Expect(tls[0]).To(HaveKeyWithValue("secretName", "jane-resume-tls"))
+
+		// the rules are read directly, as the unstructured helpers cannot copy the int port
+		rules := ingress["spec"].(map[string]interface{})["rules"].([]interface{})
+		paths := rules[0].(map[string]interface{})["http"].(map[string]interface{})["paths"].([]interface{})
+		service, _, _ := unstructured.NestedString(paths[0].(map[string]interface{}), "backend", "service", "name")
+
+		resources, err = CreateServiceResumeSvc(parent, &Members{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service).To(Equal(resources[0].GetName()))
+		Expect(service).To(Equal("jane-resume-svc"))
+	})
+
+	It("should keep the names of a Profile without a name", func() {
+		Expect(ResourceName(&resumesv1beta1.Profile{}, "resume-svc")).To(Equal("resume-svc"))
+	})
+})
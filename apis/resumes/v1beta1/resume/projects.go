@@ -0,0 +1,80 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// inlineProjects returns the projects which are listed inline within the profile.projects
+// field of a collection, as Project objects, so that they can be rendered alongside the
+// Project members of the collection.  A project is skipped when a Project member already
+// links to its URL, so that moving a project to a Project resource does not render the
+// project twice.  An inline project is named with an underscore, which the name of a
+// Project member cannot hold, so that its data file never collides with that of a member.
+func inlineProjects(collection *resumesv1beta1.Profile, members []resumesv1alpha1.Project) []resumesv1alpha1.Project {
+	linked := map[string]bool{}
+
+	for _, member := range members {
+		linked[normalizeURL(member.Spec.RepoURL)] = true
+		linked[normalizeURL(member.Spec.DemoURL)] = true
+	}
+
+	projects := []resumesv1alpha1.Project{}
+
+	for i, inline := range collection.Spec.Profile.Projects {
+		if inline.URL == "" || linked[normalizeURL(inline.URL)] {
+			continue
+		}
+
+		project := resumesv1alpha1.Project{}
+		project.Name = fmt.Sprintf("inline_%d", i)
+		project.Namespace = collection.Namespace
+		project.Spec.Title = inline.Title
+		project.Spec.Description = inline.Description
+		project.Spec.RepoURL = inline.URL
+
+		if project.Spec.Title == "" {
+			project.Spec.Title = titleFromURL(inline.URL)
+		}
+
+		projects = append(projects, project)
+	}
+
+	return projects
+}
+
+// titleFromURL derives a title for a project from the final path element of its URL.
+func titleFromURL(projectURL string) string {
+	parsed, err := url.Parse(projectURL)
+	if err != nil || strings.Trim(parsed.Path, "/") == "" {
+		return projectURL
+	}
+
+	return path.Base(strings.TrimSuffix(parsed.Path, "/"))
+}
+
+// normalizeURL returns a URL in a form suitable for comparison.
+func normalizeURL(projectURL string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(projectURL)), "/")
+}
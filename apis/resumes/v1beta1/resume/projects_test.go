@@ -0,0 +1,86 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/project"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Projects", func() {
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+	)
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				Profile: resumesv1beta1.ProfileSpecProfile{
+					Projects: []resumesv1beta1.ProfileSpecProject{
+						{URL: "https://github.com/jdoe/homelab/"},
+						{URL: "https://github.com/jdoe/dotfiles", Title: "dotfiles"},
+					},
+				},
+				Web: resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererHugo},
+			},
+		}
+
+		members = &Members{
+			Projects: []resumesv1alpha1.Project{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "inline-0", Namespace: "resumes"},
+					Spec:       resumesv1alpha1.ProjectSpec{Title: "operator", RepoURL: "https://github.com/jdoe/operator"},
+				},
+			},
+		}
+	})
+
+	It("should title an inline project after its URL", func() {
+		projects := inlineProjects(parent, members.Projects)
+		Expect(projects).To(HaveLen(2))
+		Expect(projects[0].Spec.Title).To(Equal("homelab"))
+		Expect(projects[1].Spec.Title).To(Equal("dotfiles"))
+	})
+
+	It("should skip an inline project which a Project member links to", func() {
+		members.Projects[0].Spec.RepoURL = "https://GitHub.com/jdoe/homelab"
+
+		projects := inlineProjects(parent, members.Projects)
+		Expect(projects).To(HaveLen(1))
+		Expect(projects[0].Spec.RepoURL).To(Equal("https://github.com/jdoe/dotfiles"))
+	})
+
+	It("should key the data of an inline project apart from a Project member", func() {
+		resources, err := CreateConfigMapResumeProjects(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		data, _, _ := unstructured.NestedMap(resources[0].(*unstructured.Unstructured).Object, "data")
+		Expect(data).To(HaveLen(2))
+		Expect(data).To(HaveKey("inline_0.yaml"))
+		Expect(data).To(HaveKey("inline_1.yaml"))
+		Expect(data).NotTo(HaveKey(project.DataKey(&members.Projects[0])))
+	})
+})
@@ -0,0 +1,155 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// Render is the result of generating the resources of the resume of a Profile from its
+// members.
+type Render struct {
+	// Key identifies the generation of the Profile and the versions of the members which the
+	// resources were generated from.
+	Key string
+
+	Members   *Members
+	Resources []client.Object
+	Hash      string
+
+	// Warnings describe the content of the resume which the PDF leaves out.
+	Warnings []string
+}
+
+// RenderKey returns the key of a render of a Profile from the given members, which changes
+// whenever the spec of the Profile or any of its members does.
+func RenderKey(parent *resumesv1beta1.Profile, members *Members) string {
+	key := []string{fmt.Sprintf("%s/%d", parent.UID, parent.Generation)}
+
+	eachMember(members, func(kind string, member metav1.Object) {
+		key = append(key, kind+"/"+member.GetNamespace()+"/"+member.GetName()+"/"+member.GetResourceVersion())
+	})
+
+	return strings.Join(key, ",")
+}
+
+// Renders holds the last render of each Profile, so that the resources of a resume, its PDF
+// and DOCX among them, are generated once for each change rather than each time that they
+// are compared with the resources in the cluster.
+type Renders struct {
+	mu      sync.Mutex
+	renders map[types.NamespacedName]*Render
+}
+
+// Generate returns the render of a Profile from the given members.  The last render of the
+// Profile is reused when it was generated from the same Profile and members.  The resources of
+// the render are copies, which the caller may change.
+func (renders *Renders) Generate(parent *resumesv1beta1.Profile, members *Members) (*Render, error) {
+	key := RenderKey(parent, members)
+
+	renders.mu.Lock()
+	defer renders.mu.Unlock()
+
+	render, ok := renders.renders[client.ObjectKeyFromObject(parent)]
+	if !ok || render.Key != key {
+		resources, err := Generate(*parent, *members)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := RenderHash(resources)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings, err := pdfWarnings(parent, members)
+		if err != nil {
+			return nil, err
+		}
+
+		render = &Render{Key: key, Members: members, Resources: resources, Hash: hash, Warnings: warnings}
+
+		if renders.renders == nil {
+			renders.renders = map[types.NamespacedName]*Render{}
+		}
+
+		renders.renders[client.ObjectKeyFromObject(parent)] = render
+	}
+
+	return render.copy()
+}
+
+// Last returns the last render of a Profile, or nil if it has not been rendered.  Only the
+// members, hash and warnings of the render are returned, without its resources.
+func (renders *Renders) Last(parent *resumesv1beta1.Profile) *Render {
+	renders.mu.Lock()
+	defer renders.mu.Unlock()
+
+	render, ok := renders.renders[client.ObjectKeyFromObject(parent)]
+	if !ok {
+		return nil
+	}
+
+	return &Render{Key: render.Key, Members: render.Members, Hash: render.Hash, Warnings: render.Warnings}
+}
+
+// Forget drops the last render of a Profile, once it is deleted.
+func (renders *Renders) Forget(parent *resumesv1beta1.Profile) {
+	renders.mu.Lock()
+	defer renders.mu.Unlock()
+
+	delete(renders.renders, client.ObjectKeyFromObject(parent))
+}
+
+// copy returns a copy of a render with copies of its resources.  The unstructured resources
+// are copied through their JSON, as they may hold int values which cannot be deep copied.
+func (render *Render) copy() (*Render, error) {
+	copied := *render
+	copied.Resources = make([]client.Object, 0, len(render.Resources))
+
+	for _, resource := range render.Resources {
+		resourceObj, ok := resource.(*unstructured.Unstructured)
+		if !ok {
+			copied.Resources = append(copied.Resources, resource.DeepCopyObject().(client.Object))
+
+			continue
+		}
+
+		content, err := resourceObj.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy %s %s, %w", resourceObj.GetKind(), resourceObj.GetName(), err)
+		}
+
+		copiedObj := &unstructured.Unstructured{}
+		if err := copiedObj.UnmarshalJSON(content); err != nil {
+			return nil, fmt.Errorf("unable to copy %s %s, %w", resourceObj.GetKind(), resourceObj.GetName(), err)
+		}
+
+		copied.Resources = append(copied.Resources, copiedObj)
+	}
+
+	return &copied, nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Renders", func() {
+	var (
+		renders *Renders
+		parent  *resumesv1beta1.Profile
+		members *Members
+	)
+
+	BeforeEach(func() {
+		renders = &Renders{}
+
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes", UID: "jane-uid", Generation: 1},
+			Spec: resumesv1beta1.ProfileSpec{
+				BaseURL:   "jane.example.com",
+				PageCount: 1,
+				Profile:   resumesv1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Doe"},
+				Web:       resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererNative},
+			},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "resumes", ResourceVersion: "1"},
+					Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Acme"},
+				},
+			},
+		}
+	})
+
+	It("should reuse the last render of an unchanged Profile and members", func() {
+		first, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first.Hash).NotTo(BeEmpty())
+
+		// a member which is changed in memory without a new version is not rendered again
+		members.JobExperiences[0].Spec.Employer = "Initech"
+
+		second, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Key).To(Equal(first.Key))
+		Expect(second.Hash).To(Equal(first.Hash))
+	})
+
+	It("should render again once the Profile or a member changes", func() {
+		first, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		members.JobExperiences[0].Spec.Employer = "Initech"
+		members.JobExperiences[0].ResourceVersion = "2"
+
+		second, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Hash).NotTo(Equal(first.Hash))
+
+		parent.Spec.PageTitle = "Jane Doe - Platform Engineer"
+		parent.Generation = 2
+
+		third, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(third.Hash).NotTo(Equal(second.Hash))
+		Expect(renders.Last(parent).Hash).To(Equal(third.Hash))
+	})
+
+	It("should return copies of the rendered resources", func() {
+		first, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		first.Resources[0].SetName("changed")
+
+		second, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Resources[0].GetName()).NotTo(Equal("changed"))
+		Expect(second.Resources).To(HaveLen(len(first.Resources)))
+	})
+
+	It("should forget the render of a Profile", func() {
+		_, err := renders.Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		renders.Forget(parent)
+		Expect(renders.Last(parent)).To(BeNil())
+	})
+})
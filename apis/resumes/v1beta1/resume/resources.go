@@ -0,0 +1,205 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// sampleProfile is a sample containing all fields
+const sampleProfile = `apiVersion: resumes.jefedavis.dev/v1beta1
+kind: Profile
+metadata:
+  name: profile-sample
+  namespace: default
+spec:
+  profile:
+    firstName: "John"
+    lastName: "Doe"
+    phoneNumber: ""
+    email: ""
+    linkedinURL: ""
+    githubURL: ""
+    location: "South Carolina"
+    overview: ""
+    coreCompetencies: ""
+    projects:
+      - url: "https://github.com/JefeDavis/resume-operator"
+        title: "resume-operator"
+        description: ""
+    skills: ""
+  theme:
+    palette: "green"
+    colors:
+      primary: "#4C7535"
+    fonts:
+      body: ""
+      heading: ""
+  web:
+    renderer: "native"
+    theme: "classic"
+    docx: false
+    image:
+      tag: "v0.1.0"
+      registry: ""
+      name: "jefedavis/resume"
+      pullPolicy: "IfNotPresent"
+    server:
+      image:
+        registry: ""
+        name: "busybox"
+        tag: "1.35"
+        pullPolicy: "IfNotPresent"
+  baseURL: "example.com"
+  pageTitle: "John Doe - CV"
+  pageCount: 1
+  certIssuer: "letsencrypt-staging"
+  ingressClass: "nginx"
+  memberDeletionPolicy: "Cascade"
+`
+
+// sampleProfileRequired is a sample containing only required fields
+const sampleProfileRequired = `apiVersion: resumes.jefedavis.dev/v1beta1
+kind: Profile
+metadata:
+  name: profile-sample
+  namespace: default
+spec:
+`
+
+// Sample returns the sample manifest for this custom resource.
+func Sample(requiredOnly bool) string {
+	if requiredOnly {
+		return sampleProfileRequired
+	}
+
+	return sampleProfile
+}
+
+// Generate returns the child resources that are associated with this workload given
+// appropriate structured inputs.
+func Generate(
+	collectionObj resumesv1beta1.Profile,
+	members Members,
+) ([]client.Object, error) {
+	return generate(CreateFuncs, &collectionObj, &members)
+}
+
+// generate returns the child resources which are created by the given functions from a
+// collection and its members.  It is shared by the resume and its variants.
+func generate(
+	createFuncs []func(*resumesv1beta1.Profile, *Members) ([]client.Object, error),
+	collectionObj *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// the tag of the web image is defaulted by the defaulting webhook alone, which is
+	// disabled when the controller is run locally
+	if collectionObj.Spec.Web.Image.Tag == "" {
+		collectionObj.Spec.Web.Image.Tag = resumesv1beta1.DefaultWebImageTag
+	}
+
+	resourceObjects := []client.Object{}
+
+	for _, f := range createFuncs {
+		resources, err := f(collectionObj, members)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjects = append(resourceObjects, resources...)
+	}
+
+	return resourceObjects, nil
+}
+
+// GenerateForCLI returns the child resources that are associated with this workload given
+// appropriate YAML manifest files.
+func GenerateForCLI(collectionFile []byte) ([]client.Object, error) {
+	collectionObj, err := CollectionForCLI(collectionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return Generate(*collectionObj, Members{})
+}
+
+// CollectionForCLI returns the collection of a YAML manifest file with the same defaults as
+// the defaulting webhook, so that what is built from the collection matches what the
+// controller would deploy.
+func CollectionForCLI(collectionFile []byte) (*resumesv1beta1.Profile, error) {
+	var collectionObj resumesv1beta1.Profile
+	if err := yaml.Unmarshal(collectionFile, &collectionObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal yaml into collection, %w", err)
+	}
+
+	if err := workload.Validate(&collectionObj); err != nil {
+		return nil, fmt.Errorf("error validating collection yaml, %w", err)
+	}
+
+	collectionObj.Default()
+
+	return &collectionObj, nil
+}
+
+// CreateFuncs is an array of functions that are called to create the child resources for the controller
+// in memory during the reconciliation loop prior to persisting the changes or updates to the Kubernetes
+// database.
+var CreateFuncs = []func(
+	*resumesv1beta1.Profile,
+	*Members,
+) ([]client.Object, error){
+	CreateConfigMapResumeConfig,
+	CreateConfigMapResumeProfile,
+	CreateConfigMapResumeProjects,
+	CreateConfigMapResumeAdopted,
+	CreateConfigMapResumePdf,
+	CreateConfigMapResumeDocx,
+	CreateDeploymentResume,
+	CreateConfigMapResumeSite,
+	CreateDeploymentResumeServer,
+	CreateServiceResumeSvc,
+	CreateIngressResume,
+}
+
+// InitFuncs is an array of functions that are called prior to starting the controller manager.  This is
+// necessary in instances which the controller needs to "own" objects which depend on resources to
+// pre-exist in the cluster. A common use case for this is the need to own a custom resource.
+// If the controller needs to own a custom resource type, the CRD that defines it must
+// first exist. In this case, the InitFunc will create the CRD so that the controller
+// can own custom resources of that type.  Without the InitFunc the controller will
+// crash loop because when it tries to own a non-existent resource type during manager
+// setup, it will fail.
+var InitFuncs = []func(
+	*resumesv1beta1.Profile,
+	*Members,
+) ([]client.Object, error){}
+
+func ConvertWorkload(component workload.Workload) (*resumesv1beta1.Profile, error) {
+	p, ok := component.(*resumesv1beta1.Profile)
+	if !ok {
+		return nil, resumesv1beta1.ErrUnableToConvertProfile
+	}
+
+	return p, nil
+}
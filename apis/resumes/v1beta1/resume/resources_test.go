@@ -0,0 +1,71 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Resources", func() {
+	// images returns the images of the containers of each Deployment.  The fields are read
+	// directly, as the unstructured helpers cannot copy the int fields of a container.
+	images := func(parent *resumesv1beta1.Profile) []string {
+		resources, err := Generate(*parent, Members{})
+		Expect(err).NotTo(HaveOccurred())
+
+		containerImages := []string{}
+
+		for _, resource := range resources {
+			if resource.GetObjectKind().GroupVersionKind().Kind != "Deployment" {
+				continue
+			}
+
+			deployment := resource.(*unstructured.Unstructured).Object
+			template := deployment["spec"].(map[string]interface{})["template"].(map[string]interface{})
+
+			for _, container := range template["spec"].(map[string]interface{})["containers"].([]interface{}) {
+				containerImages = append(containerImages, container.(map[string]interface{})["image"].(string))
+			}
+		}
+
+		return containerImages
+	}
+
+	It("should default the tag of the web image to the version of the operator", func() {
+		parent := &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				Web: resumesv1beta1.ProfileSpecWeb{
+					Renderer: resumesv1beta1.RendererHugo,
+					Image:    resumesv1beta1.ProfileSpecWebImage{Name: "jefedavis/resume"},
+				},
+			},
+		}
+
+		Expect(images(parent)).To(ConsistOf("jefedavis/resume:" + resumesv1beta1.DefaultWebImageTag))
+
+		parent.Spec.Web.Image.Tag = "v0.2.0"
+		Expect(images(parent)).To(ConsistOf("jefedavis/resume:v0.2.0"))
+		Expect(parent.Spec.Web.Image.Tag).To(Equal("v0.2.0"))
+	})
+})
@@ -0,0 +1,168 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// CreateConfigMapResumeConfig creates the resume-config ConfigMap resource, which configures
+// the Hugo server of the hugo renderer.
+func CreateConfigMapResumeConfig(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererHugo {
+		return []client.Object{}, nil
+	}
+
+	config, err := hugoConfigTOML(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume-config"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "hugo",
+					"app.kubernetes.io/component": "config",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by field: web.image.tag
+					"app.kubernetes.io/version": parent.Spec.Web.Image.Tag,
+				},
+			},
+			"data": map[string]interface{}{
+				// controlled by field: baseURL
+				// controlled by field: pageTitle
+				// controlled by field: pageCount
+				// controlled by field: theme
+				"config.toml": config,
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
+
+// hugoConfig is the config.toml of the Hugo server.
+type hugoConfig struct {
+	LanguageCode           string     `toml:"languageCode"`
+	DefaultContentLanguage string     `toml:"defaultContentLanguage"`
+	EnableRobotsTXT        bool       `toml:"enableRobotsTXT"`
+	EnableEmoji            bool       `toml:"enableEmoji"`
+	DisableKinds           []string   `toml:"disableKinds"`
+	BaseURL                string     `toml:"baseURL"`
+	Title                  string     `toml:"title"`
+	Params                 hugoParams `toml:"params"`
+}
+
+// hugoParams are the params of the config.toml, which are read by the theme of the site.
+type hugoParams struct {
+	EnableMetaTags                 bool   `toml:"enableMetaTags"`
+	ColorLight                     string `toml:"colorLight"`
+	ColorDark                      string `toml:"colorDark"`
+	ColorPageBackground            string `toml:"colorPageBackground"`
+	ColorPrimary                   string `toml:"colorPrimary"`
+	ColorSecondary                 string `toml:"colorSecondary"`
+	ColorHeader                    string `toml:"colorHeader"`
+	ColorHeader2                   string `toml:"colorHeader2"`
+	ColorIconPrimary               string `toml:"colorIconPrimary"`
+	ColorIconBackground            string `toml:"colorIconBackground"`
+	ColorRightColumnBackground     string `toml:"colorRightColumnBackground"`
+	ColorRightColumnHeadingText    string `toml:"colorRightColumnHeadingText"`
+	ColorRightColumnBodyText       string `toml:"colorRightColumnBodyText"`
+	ColorRightColumnIconPrimary    string `toml:"colorRightColumnIconPrimary"`
+	ColorRightColumnIconBackground string `toml:"colorRightColumnIconBackground"`
+	FontBody                       string `toml:"fontBody,omitempty"`
+	FontHeading                    string `toml:"fontHeading,omitempty"`
+	Pages                          int    `toml:"pages"`
+}
+
+// hugoConfigTOML returns the config.toml of the Hugo server.  The config is encoded rather
+// than written as text, so that any value of the fields is quoted correctly.
+func hugoConfigTOML(parent *resumesv1beta1.Profile) (string, error) {
+	colors := parent.Spec.Theme.ResolvedColors()
+
+	config := hugoConfig{
+		LanguageCode:           "en-us",
+		DefaultContentLanguage: "en",
+		EnableRobotsTXT:        true,
+		EnableEmoji:            true,
+		DisableKinds:           []string{"page", "section", "taxonomy", "term", "RSS", "sitemap"},
+		BaseURL:                "https://" + parent.Spec.BaseURL + "/",
+		Title:                  parent.Spec.PageTitle,
+		Params: hugoParams{
+			EnableMetaTags:                 true,
+			ColorLight:                     colors.Light,
+			ColorDark:                      colors.Dark,
+			ColorPageBackground:            colors.PageBackground,
+			ColorPrimary:                   colors.Primary,
+			ColorSecondary:                 colors.Secondary,
+			ColorHeader:                    colors.Header,
+			ColorHeader2:                   colors.Header2,
+			ColorIconPrimary:               colors.IconPrimary,
+			ColorIconBackground:            colors.IconBackground,
+			ColorRightColumnBackground:     colors.RightColumnBackground,
+			ColorRightColumnHeadingText:    colors.RightColumnHeadingText,
+			ColorRightColumnBodyText:       colors.RightColumnBodyText,
+			ColorRightColumnIconPrimary:    colors.RightColumnIconPrimary,
+			ColorRightColumnIconBackground: colors.RightColumnIconBackground,
+			FontBody:                       parent.Spec.Theme.Fonts.Body,
+			FontHeading:                    parent.Spec.Theme.Fonts.Heading,
+			Pages:                          parent.Spec.PageCount,
+		},
+	}
+
+	var configBuffer bytes.Buffer
+	if err := toml.NewEncoder(&configBuffer).Encode(config); err != nil {
+		return "", fmt.Errorf("unable to encode config.toml for ConfigMap, %w", err)
+	}
+
+	return configBuffer.String(), nil
+}
+
+// configChecksum returns the checksum of the config.toml of the Hugo server.
+func configChecksum(parent *resumesv1beta1.Profile) (string, error) {
+	config, err := hugoConfigTOML(parent)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(config))), nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/certification"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/experience"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// CreateConfigMapResumeAdopted creates a ConfigMap resource for each JobExperience and
+// Certification which is adopted by the memberSelector of the profile, for the hugo renderer.
+// The ConfigMap of an adopted member is rendered by the profile rather than by the member, as
+// the member may belong to another namespace, and its own ConfigMap is tailored to the
+// collection which it references.
+func CreateConfigMapResumeAdopted(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererHugo || len(members.Adopted) == 0 {
+		return []client.Object{}, nil
+	}
+
+	// the data of the members is rendered from the served version of the collection
+	collection := &resumesv1alpha1.Profile{}
+	if err := collection.ConvertFrom(parent); err != nil {
+		return nil, err
+	}
+
+	resourceObjs := []client.Object{}
+
+	for i := range members.JobExperiences {
+		member := &members.JobExperiences[i]
+
+		// controlled by field: memberSelector
+		if !members.IsAdopted(KindJobExperience, member) {
+			continue
+		}
+
+		memberObjs, err := experience.CreateConfigMapResumeExperience(member, collection)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, adoptedConfigMaps(parent, member, memberObjs)...)
+	}
+
+	for i := range members.Certifications {
+		member := &members.Certifications[i]
+
+		// controlled by field: memberSelector
+		if !members.IsAdopted(KindCertification, member) {
+			continue
+		}
+
+		memberObjs, err := certification.CreateConfigMapResumeCert(member, collection)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceObjs = append(resourceObjs, adoptedConfigMaps(parent, member, memberObjs)...)
+	}
+
+	return resourceObjs, nil
+}
+
+// adoptedConfigMaps names the ConfigMaps which are rendered for an adopted member after the
+// profile, moves them to the namespace of the profile, and keys their data file by the
+// namespace and name of the member.
+func adoptedConfigMaps(parent *resumesv1beta1.Profile, member client.Object, resourceObjs []client.Object) []client.Object {
+	for _, resourceObj := range resourceObjs {
+		resourceObj.SetName(AdoptedConfigMapName(parent, resourceObj.GetName(), member))
+		resourceObj.SetNamespace(parent.Namespace)
+
+		configMap, ok := resourceObj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+
+		// a member renders a single data file
+		data, _, _ := unstructured.NestedStringMap(configMap.Object, "data")
+		for _, content := range data {
+			configMap.Object["data"] = map[string]interface{}{AdoptedDataKey(member): content}
+		}
+	}
+
+	return resourceObjs
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// profileData is the profile.yaml data file for the hugo renderer.
+type profileData struct {
+	BasicInfo        profileBasicInfo `json:"basicInfo"`
+	Overview         string           `json:"overview"`
+	CoreCompetencies []string         `json:"coreCompetencies"`
+	Projects         []string         `json:"projects"`
+	Skills           []profileSkill   `json:"skills"`
+}
+
+// profileBasicInfo is the basic information within the profile.yaml data file.
+type profileBasicInfo struct {
+	FirstName string           `json:"firstName"`
+	LastName  string           `json:"lastName"`
+	Photo     string           `json:"photo"`
+	Contacts  []profileContact `json:"contacts"`
+}
+
+// profileContact is a contact, shown with its icon, within the profile.yaml data file.
+type profileContact struct {
+	Icon string `json:"icon"`
+	Info string `json:"info"`
+}
+
+// profileSkill is a skill family within the profile.yaml data file.
+type profileSkill struct {
+	Family string   `json:"family"`
+	Items  []string `json:"items"`
+}
+
+// profileYAML returns the profile.yaml data file for the hugo renderer, with the skills
+// tailored to the audience of the profile.  The location is always listed as a contact;
+// the other contacts are listed only when they are set.
+func profileYAML(parent *resumesv1beta1.Profile) (string, error) {
+	profile := parent.Spec.Profile
+
+	contacts := []profileContact{}
+
+	for _, contact := range []profileContact{
+		{Icon: "fa-solid fa-phone", Info: profile.PhoneNumber},
+		{Icon: "fa-solid fa-envelope", Info: profile.Email},
+		{Icon: "fa-brands fa-linkedin", Info: profile.LinkedinURL},
+		{Icon: "fa-brands fa-github", Info: profile.GithubURL},
+	} {
+		if contact.Info != "" {
+			contacts = append(contacts, contact)
+		}
+	}
+
+	contacts = append(contacts, profileContact{Icon: "fa-solid fa-map-marker-alt", Info: profile.Location})
+
+	data := profileData{
+		BasicInfo: profileBasicInfo{
+			FirstName: profile.FirstName,
+			LastName:  profile.LastName,
+			Photo:     "img/avatar.jpg",
+			Contacts:  contacts,
+		},
+		Overview:         profile.Overview,
+		CoreCompetencies: profile.CoreCompetencies,
+		Projects:         []string{},
+		Skills:           []profileSkill{},
+	}
+
+	for _, project := range profile.Projects {
+		data.Projects = append(data.Projects, project.URL)
+	}
+
+	for _, skill := range profile.Skills {
+		data.Skills = append(data.Skills, profileSkill{
+			Family: skill.Family,
+			Items:  resumesv1beta1.ItemTexts(resumesv1beta1.TailorItems(skill.Items, parent.Spec.Audience)),
+		})
+	}
+
+	marshalled, err := yaml.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("unable to scaffold profile.yaml for ConfigMap, %w", err)
+	}
+
+	return string(marshalled), nil
+}
+
+// CreateConfigMapResumeProfile creates the resume-profile ConfigMap resource, which holds the
+// profile data for the hugo renderer.
+func CreateConfigMapResumeProfile(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererHugo {
+		return []client.Object{}, nil
+	}
+
+	profile, err := profileYAML(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume-profile"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "hugo",
+					"app.kubernetes.io/component": "data",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by field: web.image.tag
+					"app.kubernetes.io/version": parent.Spec.Web.Image.Tag,
+				},
+			},
+			"data": map[string]interface{}{
+				// controlled by field: profile.firstName
+				// controlled by field: profile.lastName
+				// controlled by field: profile.phoneNumber
+				// controlled by field: profile.email
+				// controlled by field: profile.linkedinURL
+				// controlled by field: profile.githubURL
+				// controlled by field: profile.location
+				// controlled by field: profile.overview
+				// controlled by field: profile.coreCompetencies
+				// controlled by field: profile.projects
+				// controlled by field: profile.skills
+				// controlled by field: audience
+				"profile.yaml": profile,
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/project"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// CreateConfigMapResumeProjects creates the resume-projects ConfigMap resource.  It holds the
+// projects which are listed inline in the profile.projects field, rendered in the same form
+// as Project resources, for the hugo renderer.
+func CreateConfigMapResumeProjects(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererHugo {
+		return []client.Object{}, nil
+	}
+
+	data := map[string]interface{}{}
+
+	for _, inline := range inlineProjects(parent, members.Projects) {
+		inline := inline
+
+		projectData, err := project.Data(&inline)
+		if err != nil {
+			return nil, err
+		}
+
+		data[project.DataKey(&inline)] = projectData
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume-projects"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "hugo",
+					"app.kubernetes.io/component": "data",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by field: web.image.tag
+					"app.kubernetes.io/version": parent.Spec.Web.Image.Tag,
+				},
+			},
+			// controlled by field: profile.projects
+			// controlled by collection members: Project
+			"data": data,
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
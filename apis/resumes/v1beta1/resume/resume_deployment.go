@@ -20,27 +20,43 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
 )
 
-// CreateDeploymentResume creates the resume Deployment resource.
+// CreateDeploymentResume creates the resume Deployment resource which runs the Hugo server of
+// the hugo renderer.
 func CreateDeploymentResume(
-	parent *resumesv1alpha1.Profile,
+	parent *resumesv1beta1.Profile,
+	members *Members,
 ) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererHugo {
+		return []client.Object{}, nil
+	}
+
+	checksum, err := pdfChecksum(parent, members)
+	if err != nil {
+		return nil, err
+	}
+
+	configSum, err := configChecksum(parent)
+	if err != nil {
+		return nil, err
+	}
+
 	resourceObjs := []client.Object{}
 	resourceObj := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "apps/v1",
 			"kind":       "Deployment",
 			"metadata": map[string]interface{}{
-				"name": "resume",
+				"name": ResourceName(parent, "resume"),
 				"labels": map[string]interface{}{
 					"app.kubernetes.io/name":      "hugo",
 					"app.kubernetes.io/component": "webfront",
 					"app.kubernetes.io/part-of":   "resume",
-					// controlled by field: profile.firstName
-					// controlled by field: profile.lastName
-					"app.kubernetes.io/instance":   "resume-" + parent.Spec.Profile.FirstName + "" + parent.Spec.Profile.LastName + "",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
 					"app.kubernetes.io/managed-by": "resume-operator",
 					"app.kubernetes.io/created-by": "resume-controller-manager",
 					// controlled by field: web.image.tag
@@ -53,9 +69,8 @@ func CreateDeploymentResume(
 						"app.kubernetes.io/name":      "hugo",
 						"app.kubernetes.io/component": "webfront",
 						"app.kubernetes.io/part-of":   "resume",
-						// controlled by field: profile.firstName
-						// controlled by field: profile.lastName
-						"app.kubernetes.io/instance": "resume-" + parent.Spec.Profile.FirstName + "" + parent.Spec.Profile.LastName + "",
+						// controlled by field: metadata.name
+						"app.kubernetes.io/instance": InstanceLabel(parent),
 					},
 				},
 				"template": map[string]interface{}{
@@ -64,14 +79,27 @@ func CreateDeploymentResume(
 							"app.kubernetes.io/name":      "hugo",
 							"app.kubernetes.io/component": "webfront",
 							"app.kubernetes.io/part-of":   "resume",
-							// controlled by field: profile.firstName
-							// controlled by field: profile.lastName
-							"app.kubernetes.io/instance":   "resume-" + parent.Spec.Profile.FirstName + "" + parent.Spec.Profile.LastName + "",
+							// controlled by field: metadata.name
+							"app.kubernetes.io/instance":   InstanceLabel(parent),
 							"app.kubernetes.io/managed-by": "resume-operator",
 							"app.kubernetes.io/created-by": "resume-controller-manager",
 							// controlled by field: web.image.tag
 							"app.kubernetes.io/version": parent.Spec.Web.Image.Tag,
 						},
+						"annotations": map[string]interface{}{
+							// controlled by field: pageCount
+							// controlled by field: profile
+							// controlled by collection members: JobExperience
+							// controlled by collection members: Certification
+							// controlled by collection members: Education
+							// controlled by collection members: Project
+							PdfChecksumAnnotation: checksum,
+							// controlled by field: baseURL
+							// controlled by field: pageTitle
+							// controlled by field: pageCount
+							// controlled by field: theme
+							ConfigChecksumAnnotation: configSum,
+						},
 					},
 					"spec": map[string]interface{}{
 						"containers": []interface{}{
@@ -83,13 +111,19 @@ func CreateDeploymentResume(
 								"image": "" + parent.Spec.Web.Image.Registry + "" + parent.Spec.Web.Image.Name + ":" + parent.Spec.Web.Image.Tag + "",
 								// controlled by field: web.image.pullPolicy
 								"imagePullPolicy": parent.Spec.Web.Image.PullPolicy,
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "http",
+										"containerPort": 1313,
+									},
+								},
 								"args": []interface{}{
 									"server",
 									// controlled by field: baseURL
 									"--baseURL=https://" + parent.Spec.BaseURL,
 									"--appendPort=false",
 								},
-								"volumeMounts": []interface{}{
+								"volumeMounts": append([]interface{}{
 									map[string]interface{}{
 										"mountPath": "/site/data",
 										"name":      "profile-mount",
@@ -102,40 +136,80 @@ func CreateDeploymentResume(
 										"mountPath": "/site/data/certs",
 										"name":      "certs-mount",
 									},
+									map[string]interface{}{
+										"mountPath": "/site/data/education",
+										"name":      "education-mount",
+									},
+									map[string]interface{}{
+										"mountPath": "/site/data/projects",
+										"name":      "projects-mount",
+									},
 									map[string]interface{}{
 										"mountPath": "/site/config.toml",
 										"subPath":   "config.toml",
 										"name":      "config",
 									},
-								},
+									map[string]interface{}{
+										"mountPath": "/site/static/resume.pdf",
+										"subPath":   "resume.pdf",
+										"name":      "pdf",
+									},
+									// controlled by field: web.docx
+									// controlled by collection members: ResumeVariant
+								}, append(docxVolumeMounts(parent), variantVolumeMounts(members)...)...),
 							},
 						},
-						"volumes": []interface{}{
+						"volumes": append([]interface{}{
 							map[string]interface{}{
 								"name": "profile-mount",
 								"configMap": map[string]interface{}{
-									"name": "resume-profile",
+									"name": ResourceName(parent, "resume-profile"),
 								},
 							},
 							map[string]interface{}{
 								"name": "experience-mount",
-								"configMap": map[string]interface{}{
-									"name": "resume-experience",
+								"projected": map[string]interface{}{
+									// controlled by collection members: JobExperience
+									"sources": experienceSources(parent, members),
 								},
 							},
 							map[string]interface{}{
 								"name": "certs-mount",
-								"configMap": map[string]interface{}{
-									"name": "resume-cert",
+								"projected": map[string]interface{}{
+									// controlled by collection members: Certification
+									"sources": certificationSources(parent, members),
+								},
+							},
+							map[string]interface{}{
+								"name": "education-mount",
+								"projected": map[string]interface{}{
+									// controlled by collection members: Education
+									"sources": educationSources(members),
+								},
+							},
+							map[string]interface{}{
+								"name": "projects-mount",
+								"projected": map[string]interface{}{
+									// controlled by field: profile.projects
+									// controlled by collection members: Project
+									"sources": projectSources(parent, members),
 								},
 							},
 							map[string]interface{}{
 								"name": "config",
 								"configMap": map[string]interface{}{
-									"name": "resume-config",
+									"name": ResourceName(parent, "resume-config"),
 								},
 							},
-						},
+							map[string]interface{}{
+								"name": "pdf",
+								"configMap": map[string]interface{}{
+									"name": ResourceName(parent, "resume-pdf"),
+								},
+							},
+							// controlled by field: web.docx
+							// controlled by collection members: ResumeVariant
+						}, append(docxVolumes(parent), variantVolumes(parent, members)...)...),
 					},
 				},
 			},
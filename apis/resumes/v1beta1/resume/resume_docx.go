@@ -0,0 +1,127 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+// CreateConfigMapResumeDocx creates the resume-docx ConfigMap resource, which holds the DOCX
+// of the resume for applicant tracking systems.  The DOCX is served by the resume site as
+// resume.docx.
+func CreateConfigMapResumeDocx(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.docx
+	if !parent.Spec.Web.Docx {
+		return []client.Object{}, nil
+	}
+
+	docx, err := render.DOCX(Resume(parent, members))
+	if err != nil {
+		return nil, fmt.Errorf("unable to render resume.docx for ConfigMap, %w", err)
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume-docx"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "resume",
+					"app.kubernetes.io/component": "docx",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+				},
+			},
+			"binaryData": map[string]interface{}{
+				// controlled by field: pageTitle
+				// controlled by field: profile
+				// controlled by collection members: JobExperience
+				// controlled by collection members: Certification
+				// controlled by collection members: Education
+				// controlled by collection members: Project
+				"resume.docx": base64.StdEncoding.EncodeToString(docx),
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
+
+// docxSources returns the projected volume sources of the DOCX for the server of the native
+// renderer, when the DOCX is served.
+func docxSources(parent *resumesv1beta1.Profile) []interface{} {
+	if !parent.Spec.Web.Docx {
+		return []interface{}{}
+	}
+
+	return []interface{}{configMapSource(ResourceName(parent, "resume-docx"))}
+}
+
+// docxVolumeMounts returns the volume mounts of the DOCX for the Hugo server, when the DOCX is
+// served.  The DOCX is mounted as a single file like the PDF, and is rendered from the same
+// data, so the pods are replaced by the checksum of the PDF when it changes.
+func docxVolumeMounts(parent *resumesv1beta1.Profile) []interface{} {
+	if !parent.Spec.Web.Docx {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mountPath": "/site/static/resume.docx",
+			"subPath":   "resume.docx",
+			"name":      "docx",
+		},
+	}
+}
+
+// docxVolumes returns the volumes of the DOCX for the Hugo server, when the DOCX is served.
+// The volume is optional, so that the pods start while the ConfigMap is created alongside the
+// Deployment once the DOCX is first served.
+func docxVolumes(parent *resumesv1beta1.Profile) []interface{} {
+	if !parent.Spec.Web.Docx {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name": "docx",
+			"configMap": map[string]interface{}{
+				"name":     ResourceName(parent, "resume-docx"),
+				"optional": true,
+			},
+		},
+	}
+}
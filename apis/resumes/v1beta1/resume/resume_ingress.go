@@ -20,12 +20,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
 )
 
 // CreateIngressResume creates the resume Ingress resource.
 func CreateIngressResume(
-	parent *resumesv1alpha1.Profile,
+	parent *resumesv1beta1.Profile,
+	members *Members,
 ) ([]client.Object, error) {
 	resourceObjs := []client.Object{}
 	resourceObj := &unstructured.Unstructured{
@@ -33,14 +34,13 @@ func CreateIngressResume(
 			"apiVersion": "networking.k8s.io/v1",
 			"kind":       "Ingress",
 			"metadata": map[string]interface{}{
-				"name": "resume",
+				"name": ResourceName(parent, "resume"),
 				"labels": map[string]interface{}{
 					"app.kubernetes.io/name":      "hugo",
 					"app.kubernetes.io/component": "webfront",
 					"app.kubernetes.io/part-of":   "resume",
-					// controlled by field: profile.firstName
-					// controlled by field: profile.lastName
-					"app.kubernetes.io/instance":   "resume-" + parent.Spec.Profile.FirstName + "" + parent.Spec.Profile.LastName + "",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
 					"app.kubernetes.io/managed-by": "resume-operator",
 					"app.kubernetes.io/created-by": "resume-controller-manager",
 					// controlled by field: web.image.tag
@@ -60,7 +60,7 @@ func CreateIngressResume(
 							// controlled by field: baseURL
 							parent.Spec.BaseURL,
 						},
-						"secretName": "resume-tls",
+						"secretName": ResourceName(parent, "resume-tls"),
 					},
 				},
 				"rules": []interface{}{
@@ -74,25 +74,13 @@ func CreateIngressResume(
 									"path":     "/",
 									"backend": map[string]interface{}{
 										"service": map[string]interface{}{
-											"name": "resume-svc",
+											"name": ResourceName(parent, "resume-svc"),
 											"port": map[string]interface{}{
 												"number": 8080,
 											},
 										},
 									},
 								},
-								map[string]interface{}{
-									"pathType": "Prefix",
-									"path":     "/convert",
-									"backend": map[string]interface{}{
-										"service": map[string]interface{}{
-											"name": "pdf-converter-svc",
-											"port": map[string]interface{}{
-												"number": 3000,
-											},
-										},
-									},
-								},
 							},
 						},
 					},
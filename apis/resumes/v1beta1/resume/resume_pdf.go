@@ -0,0 +1,108 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+// PdfChecksumAnnotation is set on the pods of the hugo renderer with the checksum of the PDF,
+// so that the pods are replaced when the PDF changes.  The hugo renderer mounts the PDF as a
+// single file, which the kubelet does not update in a running pod.
+const PdfChecksumAnnotation = "resumes.jefedavis.dev/pdf-checksum"
+
+// ConfigChecksumAnnotation is set on the pods of the hugo renderer with the checksum of the
+// config.toml, which is also mounted as a single file, so that the pods are replaced when the
+// config changes.
+const ConfigChecksumAnnotation = "resumes.jefedavis.dev/config-checksum"
+
+// CreateConfigMapResumePdf creates the resume-pdf ConfigMap resource, which holds the PDF of
+// the resume.  The PDF is served by the resume site as resume.pdf.
+func CreateConfigMapResumePdf(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	pdf, err := render.PDF(Resume(parent, members))
+	if err != nil {
+		return nil, fmt.Errorf("unable to render resume.pdf for ConfigMap, %w", err)
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume-pdf"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "resume",
+					"app.kubernetes.io/component": "pdf",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+				},
+			},
+			"binaryData": map[string]interface{}{
+				// controlled by field: pageTitle
+				// controlled by field: pageCount
+				// controlled by field: profile
+				// controlled by collection members: JobExperience
+				// controlled by collection members: Certification
+				// controlled by collection members: Education
+				// controlled by collection members: Project
+				"resume.pdf": base64.StdEncoding.EncodeToString(pdf),
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
+
+// pdfChecksum returns the checksum of the PDF of the resume.
+func pdfChecksum(parent *resumesv1beta1.Profile, members *Members) (string, error) {
+	pdf, err := render.PDF(Resume(parent, members))
+	if err != nil {
+		return "", fmt.Errorf("unable to render resume.pdf for checksum, %w", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(pdf)), nil
+}
+
+// pdfWarnings returns the warnings of the PDF of the resume, which describe the content that
+// does not fit its pageCount.
+func pdfWarnings(parent *resumesv1beta1.Profile, members *Members) ([]string, error) {
+	_, warnings, err := render.PDFWithWarnings(Resume(parent, members))
+	if err != nil {
+		return nil, fmt.Errorf("unable to render resume.pdf for warnings, %w", err)
+	}
+
+	return warnings, nil
+}
@@ -20,12 +20,13 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
 )
 
 // CreateServiceResumeSvc creates the resume-svc Service resource.
 func CreateServiceResumeSvc(
-	parent *resumesv1alpha1.Profile,
+	parent *resumesv1beta1.Profile,
+	members *Members,
 ) ([]client.Object, error) {
 	resourceObjs := []client.Object{}
 	resourceObj := &unstructured.Unstructured{
@@ -33,14 +34,13 @@ func CreateServiceResumeSvc(
 			"apiVersion": "v1",
 			"kind":       "Service",
 			"metadata": map[string]interface{}{
-				"name": "resume-svc",
+				"name": ResourceName(parent, "resume-svc"),
 				"labels": map[string]interface{}{
 					"app.kubernetes.io/name":      "hugo",
 					"app.kubernetes.io/component": "webfront",
 					"app.kubernetes.io/part-of":   "resume",
-					// controlled by field: profile.firstName
-					// controlled by field: profile.lastName
-					"app.kubernetes.io/instance":   "resume-" + parent.Spec.Profile.FirstName + "" + parent.Spec.Profile.LastName + "",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
 					"app.kubernetes.io/managed-by": "resume-operator",
 					"app.kubernetes.io/created-by": "resume-controller-manager",
 					// controlled by field: web.image.tag
@@ -51,14 +51,13 @@ func CreateServiceResumeSvc(
 				"selector": map[string]interface{}{
 					"app.kubernetes.io/name":      "hugo",
 					"app.kubernetes.io/component": "webfront",
-					// controlled by field: profile.firstName
-					// controlled by field: profile.lastName
-					"app.kubernetes.io/instance": "resume-" + parent.Spec.Profile.FirstName + "" + parent.Spec.Profile.LastName + "",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance": InstanceLabel(parent),
 				},
 				"ports": []interface{}{
 					map[string]interface{}{
 						"port":       8080,
-						"targetPort": 1313,
+						"targetPort": "http",
 					},
 				},
 			},
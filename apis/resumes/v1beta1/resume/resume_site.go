@@ -0,0 +1,240 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+// Resume returns the data which the resume of a collection is rendered from.  The projects
+// which are listed inline on the profile are rendered after the Project members, and the
+// skills and highlights are tailored to the audience of the collection.
+func Resume(parent *resumesv1beta1.Profile, members *Members) *render.Resume {
+	projects := append([]resumesv1alpha1.Project{}, members.Projects...)
+
+	profile := parent.DeepCopy()
+	for i := range profile.Spec.Profile.Skills {
+		profile.Spec.Profile.Skills[i].Items = resumesv1beta1.TailorItems(profile.Spec.Profile.Skills[i].Items, parent.Spec.Audience)
+	}
+
+	jobExperiences := []resumesv1alpha1.JobExperience{}
+
+	for i := range members.JobExperiences {
+		jobExperience := members.JobExperiences[i].DeepCopy()
+		for j := range jobExperience.Spec.Positions {
+			jobExperience.Spec.Positions[j].Highlights = resumesv1alpha1.TailorItems(jobExperience.Spec.Positions[j].Highlights, parent.Spec.Audience)
+		}
+
+		jobExperiences = append(jobExperiences, *jobExperience)
+	}
+
+	return &render.Resume{
+		Profile:        *profile,
+		JobExperiences: jobExperiences,
+		Certifications: members.Certifications,
+		Educations:     members.Educations,
+		Projects:       append(projects, inlineProjects(parent, members.Projects)...),
+	}
+}
+
+// CreateConfigMapResumeSite creates the resume-site ConfigMap resource, which holds the page
+// rendered by the native renderer.
+func CreateConfigMapResumeSite(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererNative {
+		return []client.Object{}, nil
+	}
+
+	page, err := render.HTML(Resume(parent, members))
+	if err != nil {
+		return nil, fmt.Errorf("unable to render index.html for ConfigMap, %w", err)
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume-site"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "resume",
+					"app.kubernetes.io/component": "site",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by field: web.server.image.tag
+					"app.kubernetes.io/version": parent.Spec.Web.Server.Image.Tag,
+				},
+			},
+			"data": map[string]interface{}{
+				// controlled by field: web.theme
+				// controlled by field: pageTitle
+				// controlled by field: profile
+				// controlled by collection members: JobExperience
+				// controlled by collection members: Certification
+				// controlled by collection members: Education
+				// controlled by collection members: Project
+				"index.html": string(page),
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
+
+// CreateDeploymentResumeServer creates the resume Deployment resource which serves the page
+// rendered by the native renderer, along with the PDF and DOCX, with a static file server.
+// The selector is shared with the Deployment of the hugo renderer, as the selector of a
+// Deployment may not be changed when switching between the renderers.
+func CreateDeploymentResumeServer(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+) ([]client.Object, error) {
+	// controlled by field: web.renderer
+	if parent.Spec.Web.Renderer != resumesv1beta1.RendererNative {
+		return []client.Object{}, nil
+	}
+
+	resourceObjs := []client.Object{}
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name": ResourceName(parent, "resume"),
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":      "hugo",
+					"app.kubernetes.io/component": "webfront",
+					"app.kubernetes.io/part-of":   "resume",
+					// controlled by field: metadata.name
+					"app.kubernetes.io/instance":   InstanceLabel(parent),
+					"app.kubernetes.io/managed-by": "resume-operator",
+					"app.kubernetes.io/created-by": "resume-controller-manager",
+					// controlled by field: web.server.image.tag
+					"app.kubernetes.io/version": parent.Spec.Web.Server.Image.Tag,
+				},
+			},
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app.kubernetes.io/name":      "hugo",
+						"app.kubernetes.io/component": "webfront",
+						"app.kubernetes.io/part-of":   "resume",
+						// controlled by field: metadata.name
+						"app.kubernetes.io/instance": InstanceLabel(parent),
+					},
+				},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"app.kubernetes.io/name":      "hugo",
+							"app.kubernetes.io/component": "webfront",
+							"app.kubernetes.io/part-of":   "resume",
+							// controlled by field: metadata.name
+							"app.kubernetes.io/instance":   InstanceLabel(parent),
+							"app.kubernetes.io/managed-by": "resume-operator",
+							"app.kubernetes.io/created-by": "resume-controller-manager",
+							// controlled by field: web.server.image.tag
+							"app.kubernetes.io/version": parent.Spec.Web.Server.Image.Tag,
+						},
+					},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name": "resume",
+								// controlled by field: web.server.image.registry
+								// controlled by field: web.server.image.name
+								// controlled by field: web.server.image.tag
+								"image": "" + parent.Spec.Web.Server.Image.Registry + "" + parent.Spec.Web.Server.Image.Name + ":" + parent.Spec.Web.Server.Image.Tag + "",
+								// controlled by field: web.server.image.pullPolicy
+								"imagePullPolicy": parent.Spec.Web.Server.Image.PullPolicy,
+								"args": []interface{}{
+									"httpd",
+									"-f",
+									"-p",
+									"8080",
+									"-h",
+									"/srv/www",
+								},
+								"ports": []interface{}{
+									map[string]interface{}{
+										"name":          "http",
+										"containerPort": 8080,
+									},
+								},
+								"securityContext": map[string]interface{}{
+									"runAsNonRoot":             true,
+									"runAsUser":                65534,
+									"readOnlyRootFilesystem":   true,
+									"allowPrivilegeEscalation": false,
+									"capabilities": map[string]interface{}{
+										"drop": []interface{}{
+											"ALL",
+										},
+									},
+								},
+								"volumeMounts": []interface{}{
+									map[string]interface{}{
+										"mountPath": "/srv/www",
+										"name":      "site",
+										"readOnly":  true,
+									},
+								},
+							},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name": "site",
+								"projected": map[string]interface{}{
+									// controlled by field: web.docx
+									// controlled by collection members: ResumeVariant
+									"sources": append(append([]interface{}{
+										configMapSource(ResourceName(parent, "resume-site")),
+										configMapSource(ResourceName(parent, "resume-pdf")),
+									}, docxSources(parent)...), variantSources(parent, members)...),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resourceObj.SetNamespace(parent.Namespace)
+
+	resourceObjs = append(resourceObjs, resourceObj)
+
+	return resourceObjs, nil
+}
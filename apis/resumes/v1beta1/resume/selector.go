@@ -0,0 +1,248 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// Kinds of the members which a collection adopts through its memberSelector.
+const (
+	KindJobExperience = "JobExperience"
+	KindCertification = "Certification"
+)
+
+// AdoptedKey returns the key of a member of the given kind within Members.Adopted.
+func AdoptedKey(kind string, member metav1.Object) string {
+	return kind + "/" + member.GetNamespace() + "/" + member.GetName()
+}
+
+// IsAdopted returns whether a member of the given kind is adopted by the memberSelector of
+// the collection, rather than referencing it.
+func (members *Members) IsAdopted(kind string, member metav1.Object) bool {
+	return members.Adopted[AdoptedKey(kind, member)]
+}
+
+// adopt marks a member of the given kind as adopted by the collection.
+func (members *Members) adopt(kind string, member metav1.Object) {
+	if members.Adopted == nil {
+		members.Adopted = map[string]bool{}
+	}
+
+	members.Adopted[AdoptedKey(kind, member)] = true
+}
+
+// AdoptedDataKey returns the key of the data file of a member that is adopted by a collection.
+// The data files of all members are projected into one directory, and the data file of a
+// member is otherwise keyed by its employer or alias, which is only unique among the members
+// of one collection.  The key of an adopted member is made of its namespace and name instead,
+// which neither the names of other members nor their employers and aliases use.
+func AdoptedDataKey(member metav1.Object) string {
+	return member.GetNamespace() + "__" + member.GetName() + ".yaml"
+}
+
+// AdoptedConfigMapName returns the name of the ConfigMap which holds the rendered data of a
+// member that is adopted by a collection, given the name of the ConfigMap of the member
+// itself.  The namespace of the member is part of the name, as the members of several
+// namespaces may share a name.
+func AdoptedConfigMapName(parent *resumesv1beta1.Profile, configMapName string, member metav1.Object) string {
+	return ResourceName(parent, configMapName+"-"+member.GetNamespace())
+}
+
+// memberSelectors returns the selectors of the memberSelector of a collection.  A nil
+// selector adopts no members, and a nil namespace selector selects the namespace of the
+// collection only.
+func memberSelectors(collection *resumesv1beta1.Profile) (namespaceSelector, selector labels.Selector, err error) {
+	memberSelector := collection.Spec.MemberSelector
+	if memberSelector == nil || memberSelector.Selector == nil {
+		return nil, nil, nil
+	}
+
+	selector, err = metav1.LabelSelectorAsSelector(memberSelector.Selector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid memberSelector.selector of Profile %s, %w", collection.Name, err)
+	}
+
+	if memberSelector.NamespaceSelector == nil {
+		return nil, selector, nil
+	}
+
+	namespaceSelector, err = metav1.LabelSelectorAsSelector(memberSelector.NamespaceSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid memberSelector.namespaceSelector of Profile %s, %w", collection.Name, err)
+	}
+
+	return namespaceSelector, selector, nil
+}
+
+// sharesMembers returns whether the members of a namespace may be adopted by a collection:
+// the namespace of the collection always does, and any other namespace only when it is
+// labeled to share its members.
+func sharesMembers(collection *resumesv1beta1.Profile, namespace *corev1.Namespace) bool {
+	return namespace.Name == collection.Namespace || namespace.Labels[resumesv1beta1.SharedMembersLabel] == "true"
+}
+
+// adoptMembers adds the JobExperiences and Certifications which are selected by the
+// memberSelector of the collection to its members, and marks them as adopted.  The members
+// which belong to the collection already are left as they are.
+func adoptMembers(ctx context.Context, reader client.Reader, collection *resumesv1beta1.Profile, members *Members) error {
+	namespaceSelector, selector, err := memberSelectors(collection)
+	if err != nil {
+		return err
+	}
+
+	if selector == nil {
+		return nil
+	}
+
+	namespaces := []string{collection.Namespace}
+
+	if namespaceSelector != nil {
+		var namespaceList corev1.NamespaceList
+
+		if err := reader.List(ctx, &namespaceList, client.MatchingLabelsSelector{Selector: namespaceSelector}); err != nil {
+			return fmt.Errorf("unable to list namespaces of members, %w", err)
+		}
+
+		namespaces = []string{}
+		for i := range namespaceList.Items {
+			if sharesMembers(collection, &namespaceList.Items[i]) {
+				namespaces = append(namespaces, namespaceList.Items[i].Name)
+			}
+		}
+	}
+
+	existing := map[string]bool{}
+
+	for i := range members.JobExperiences {
+		existing[AdoptedKey(KindJobExperience, &members.JobExperiences[i])] = true
+	}
+
+	for i := range members.Certifications {
+		existing[AdoptedKey(KindCertification, &members.Certifications[i])] = true
+	}
+
+	for _, namespace := range namespaces {
+		options := []client.ListOption{client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}}
+
+		var jobExperienceList resumesv1alpha1.JobExperienceList
+
+		if err := reader.List(ctx, &jobExperienceList, options...); err != nil {
+			return fmt.Errorf("unable to list JobExperience members, %w", err)
+		}
+
+		for i := range jobExperienceList.Items {
+			member := &jobExperienceList.Items[i]
+
+			if !member.GetDeletionTimestamp().IsZero() || existing[AdoptedKey(KindJobExperience, member)] {
+				continue
+			}
+
+			members.JobExperiences = append(members.JobExperiences, *member)
+			members.adopt(KindJobExperience, member)
+		}
+
+		var certificationList resumesv1alpha1.CertificationList
+
+		if err := reader.List(ctx, &certificationList, options...); err != nil {
+			return fmt.Errorf("unable to list Certification members, %w", err)
+		}
+
+		for i := range certificationList.Items {
+			member := &certificationList.Items[i]
+
+			if !member.GetDeletionTimestamp().IsZero() || existing[AdoptedKey(KindCertification, member)] {
+				continue
+			}
+
+			members.Certifications = append(members.Certifications, *member)
+			members.adopt(KindCertification, member)
+		}
+	}
+
+	return nil
+}
+
+// SelectsLabels returns whether the memberSelector of a collection selects a member by its
+// labels.  When the collection selects namespaces by their labels, the namespace of the
+// member is not checked, nor whether it shares its members, so that it is cheap enough to map
+// events of a member to the collections which may adopt it.
+func SelectsLabels(collection *resumesv1beta1.Profile, member client.Object) bool {
+	namespaceSelector, selector, err := memberSelectors(collection)
+	if err != nil || selector == nil {
+		return false
+	}
+
+	if namespaceSelector == nil && member.GetNamespace() != collection.Namespace {
+		return false
+	}
+
+	return selector.Matches(labels.Set(member.GetLabels()))
+}
+
+// SelectingCollections returns the collections which adopt a member through their
+// memberSelector.  Collections which are being deleted adopt no members, and collections of
+// other namespaces only adopt the members of namespaces which share them.
+func SelectingCollections(ctx context.Context, reader client.Reader, member client.Object) ([]resumesv1beta1.Profile, error) {
+	var collectionList resumesv1beta1.ProfileList
+
+	if err := reader.List(ctx, &collectionList); err != nil {
+		return nil, fmt.Errorf("unable to list collection Profile, %w", err)
+	}
+
+	var namespace *corev1.Namespace
+
+	collections := []resumesv1beta1.Profile{}
+
+	for i := range collectionList.Items {
+		collection := &collectionList.Items[i]
+
+		if !collection.GetDeletionTimestamp().IsZero() || !SelectsLabels(collection, member) {
+			continue
+		}
+
+		namespaceSelector, _, _ := memberSelectors(collection)
+
+		if namespaceSelector != nil {
+			// the namespace of the member is read once, for the first collection which needs it
+			if namespace == nil {
+				namespace = &corev1.Namespace{}
+
+				if err := reader.Get(ctx, client.ObjectKey{Name: member.GetNamespace()}, namespace); err != nil {
+					return nil, fmt.Errorf("unable to get namespace %s, %w", member.GetNamespace(), err)
+				}
+			}
+
+			if !namespaceSelector.Matches(labels.Set(namespace.Labels)) || !sharesMembers(collection, namespace) {
+				continue
+			}
+		}
+
+		collections = append(collections, *collection)
+	}
+
+	return collections, nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Selector", func() {
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+		adopted *resumesv1alpha1.JobExperience
+	)
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team"},
+			Spec: resumesv1beta1.ProfileSpec{
+				PageCount: 1,
+				Profile:   resumesv1beta1.ProfileSpecProfile{FirstName: "Platform", LastName: "Team"},
+				Web:       resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererHugo},
+				MemberSelector: &resumesv1beta1.ProfileSpecMemberSelector{
+					NamespaceSelector: &metav1.LabelSelector{},
+					Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"team": "platform"}},
+				},
+			},
+		}
+
+		adopted = &resumesv1alpha1.JobExperience{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "jane", Labels: map[string]string{"team": "platform"}},
+			Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Acme"},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				*adopted,
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "initech", Namespace: "team"},
+					Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Initech"},
+				},
+			},
+		}
+		members.adopt(KindJobExperience, adopted)
+	})
+
+	// names returns the name of each resource.
+	names := func(resources []client.Object) []string {
+		resourceNames := []string{}
+		for _, resource := range resources {
+			resourceNames = append(resourceNames, resource.GetName())
+		}
+
+		return resourceNames
+	}
+
+	It("should select the members by their labels", func() {
+		Expect(SelectsLabels(parent, adopted)).To(BeTrue())
+
+		adopted.Labels = map[string]string{"team": "data"}
+		Expect(SelectsLabels(parent, adopted)).To(BeFalse())
+	})
+
+	It("should select the members of the namespace of the profile without a namespace selector", func() {
+		parent.Spec.MemberSelector.NamespaceSelector = nil
+		Expect(SelectsLabels(parent, adopted)).To(BeFalse())
+
+		adopted.Namespace = "team"
+		Expect(SelectsLabels(parent, adopted)).To(BeTrue())
+	})
+
+	It("should select no members without a selector", func() {
+		parent.Spec.MemberSelector.Selector = nil
+		Expect(SelectsLabels(parent, adopted)).To(BeFalse())
+
+		parent.Spec.MemberSelector = nil
+		Expect(SelectsLabels(parent, adopted)).To(BeFalse())
+	})
+
+	It("should render the ConfigMap of an adopted member in the namespace of the profile", func() {
+		resources, err := CreateConfigMapResumeAdopted(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(names(resources)).To(ConsistOf("team-resume-experience-acme-jane"))
+		Expect(resources[0].GetNamespace()).To(Equal("team"))
+
+		// the data file is keyed by the member rather than by its employer
+		data, _, _ := unstructured.NestedStringMap(resources[0].(*unstructured.Unstructured).Object, "data")
+		Expect(data).To(HaveLen(1))
+		Expect(data).To(HaveKey("jane__acme.yaml"))
+		Expect(data["jane__acme.yaml"]).To(ContainSubstring("Acme"))
+
+		sources := experienceSources(parent, members)
+		Expect(sources).To(HaveLen(2))
+
+		for i, name := range []string{"team-resume-experience-acme-jane", "resume-experience-initech"} {
+			source, _, _ := unstructured.NestedString(sources[i].(map[string]interface{}), "configMap", "name")
+			Expect(source).To(Equal(name))
+		}
+	})
+
+	It("should only adopt the members of other namespaces which share them", func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(resumesv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(resumesv1beta1.AddToScheme(scheme)).To(Succeed())
+
+		platform := map[string]string{"team": "platform"}
+		shared := map[string]string{"team": "platform", resumesv1beta1.SharedMembersLabel: "true"}
+
+		jobExperience := func(name, namespace string) *resumesv1alpha1.JobExperience {
+			return &resumesv1alpha1.JobExperience{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: platform},
+				Spec:       resumesv1alpha1.JobExperienceSpec{Employer: name},
+			}
+		}
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team", Labels: platform}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "jane", Labels: platform}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "john", Labels: shared}},
+			jobExperience("local", "team"),
+			jobExperience("acme", "jane"),
+			jobExperience("initech", "john"),
+		).Build()
+
+		adoptedMembers := &Members{}
+		Expect(adoptMembers(context.Background(), c, parent, adoptedMembers)).To(Succeed())
+
+		adoptedNames := []string{}
+		for i := range adoptedMembers.JobExperiences {
+			adoptedNames = append(adoptedNames, adoptedMembers.JobExperiences[i].Namespace+"/"+adoptedMembers.JobExperiences[i].Name)
+		}
+
+		Expect(adoptedNames).To(ConsistOf("team/local", "john/initech"))
+
+		// the member reports the collections which adopt it alike
+		var profile resumesv1beta1.Profile
+		parent.DeepCopyInto(&profile)
+		Expect(c.Create(context.Background(), &profile)).To(Succeed())
+
+		for namespace, adopters := range map[string]int{"team": 1, "jane": 0, "john": 1} {
+			collections, err := SelectingCollections(context.Background(), c, jobExperience("member", namespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collections).To(HaveLen(adopters), namespace)
+		}
+	})
+
+	It("should only render the ConfigMap of an adopted member for the hugo renderer", func() {
+		parent.Spec.Web.Renderer = resumesv1beta1.RendererNative
+
+		resources, err := CreateConfigMapResumeAdopted(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(BeEmpty())
+	})
+
+	It("should leave the ConfigMap of an adopted member out of the member resources", func() {
+		resources, err := MemberResources(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(names(resources)).To(ConsistOf("resume-experience-initech"))
+	})
+})
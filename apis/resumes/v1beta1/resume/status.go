@@ -0,0 +1,141 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// SiteURL returns the URL at which the resume site of a Profile is served.
+func SiteURL(parent *resumesv1beta1.Profile) string {
+	return "https://" + parent.Spec.BaseURL + "/"
+}
+
+// PdfURL returns the URL at which the PDF of the resume of a Profile is downloaded.
+func PdfURL(parent *resumesv1beta1.Profile) string {
+	return SiteURL(parent) + "resume.pdf"
+}
+
+// RenderHash returns the checksum of the resources which are rendered for a resume.
+func RenderHash(resources []client.Object) (string, error) {
+	hash := sha256.New()
+
+	for _, resource := range resources {
+		content, err := json.Marshal(resource)
+		if err != nil {
+			return "", fmt.Errorf("unable to marshal %s for checksum, %w", resource.GetName(), err)
+		}
+
+		hash.Write(content)
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// eachMember calls fn with each of the members of a collection, by kind.
+func eachMember(members *Members, fn func(kind string, member metav1.Object)) {
+	for i := range members.JobExperiences {
+		fn(KindJobExperience, &members.JobExperiences[i])
+	}
+
+	for i := range members.Certifications {
+		fn(KindCertification, &members.Certifications[i])
+	}
+
+	for i := range members.Educations {
+		fn("Education", &members.Educations[i])
+	}
+
+	for i := range members.Projects {
+		fn("Project", &members.Projects[i])
+	}
+
+	for i := range members.Variants {
+		fn("ResumeVariant", &members.Variants[i])
+	}
+}
+
+// MemberReferences returns a reference to each of the members of a collection, by kind.
+func MemberReferences(members *Members) []corev1.ObjectReference {
+	references := []corev1.ObjectReference{}
+
+	eachMember(members, func(kind string, member metav1.Object) {
+		references = append(references, corev1.ObjectReference{
+			APIVersion: resumesv1alpha1.GroupVersion.String(),
+			Kind:       kind,
+			Namespace:  member.GetNamespace(),
+			Name:       member.GetName(),
+		})
+	})
+
+	return references
+}
+
+// SetRenderStatus sets the status of a Profile from a render of its resume which has been
+// applied.  The time of the render is only moved on when the rendered resources change, so
+// that the status is not updated on every reconcile.  A PDF which cuts off the resume is
+// reported by a render condition.
+func SetRenderStatus(parent *resumesv1beta1.Profile, render *Render) {
+	parent.Status.URL = SiteURL(parent)
+	parent.Status.PdfURL = PdfURL(parent)
+	parent.Status.JobExperienceCount = int32(len(render.Members.JobExperiences))
+	parent.Status.CertificationCount = int32(len(render.Members.Certifications))
+	parent.Status.Members = MemberReferences(render.Members)
+	parent.Status.ObservedGeneration = parent.Generation
+
+	if parent.Status.LastRenderHash != render.Hash || parent.Status.LastRenderTime == nil {
+		now := metav1.Now()
+
+		parent.Status.LastRenderHash = render.Hash
+		parent.Status.LastRenderTime = &now
+	}
+
+	condition := PdfCondition(render.Warnings)
+	condition.ObservedGeneration = parent.Generation
+	meta.SetStatusCondition(&parent.Status.RenderConditions, condition)
+}
+
+// PdfCondition returns the render condition of the PDF of a resume from the warnings of its
+// render, which is false when the PDF cuts off the content of the resume.
+func PdfCondition(warnings []string) metav1.Condition {
+	if len(warnings) > 0 {
+		return metav1.Condition{
+			Type:    resumesv1beta1.ConditionPdfFits,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ContentCutOff",
+			Message: strings.Join(warnings, "; "),
+		}
+	}
+
+	return metav1.Condition{
+		Type:    resumesv1beta1.ConditionPdfFits,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Fits",
+		Message: "the resume fits the pageCount of the PDF",
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Status", func() {
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+	)
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes", Generation: 3},
+			Spec: resumesv1beta1.ProfileSpec{
+				BaseURL:   "jane.example.com",
+				PageCount: 1,
+				Profile:   resumesv1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Doe"},
+				Web:       resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererNative},
+			},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "resumes"},
+					Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Acme"},
+				},
+			},
+		}
+	})
+
+	// render generates the resources of the resume and sets the status from them.
+	render := func() {
+		rendered, err := (&Renders{}).Generate(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+
+		SetRenderStatus(parent, rendered)
+	}
+
+	It("should set the URLs, members and generation of a render", func() {
+		render()
+
+		Expect(parent.Status.URL).To(Equal("https://jane.example.com/"))
+		Expect(parent.Status.PdfURL).To(Equal("https://jane.example.com/resume.pdf"))
+		Expect(parent.Status.JobExperienceCount).To(Equal(int32(1)))
+		Expect(parent.Status.CertificationCount).To(Equal(int32(0)))
+		Expect(parent.Status.ObservedGeneration).To(Equal(int64(3)))
+		Expect(parent.Status.LastRenderHash).NotTo(BeEmpty())
+		Expect(parent.Status.LastRenderTime).NotTo(BeNil())
+
+		Expect(parent.Status.Members).To(HaveLen(1))
+		Expect(parent.Status.Members[0].APIVersion).To(Equal("resumes.jefedavis.dev/v1alpha1"))
+		Expect(parent.Status.Members[0].Kind).To(Equal("JobExperience"))
+		Expect(parent.Status.Members[0].Namespace).To(Equal("resumes"))
+		Expect(parent.Status.Members[0].Name).To(Equal("acme"))
+	})
+
+	It("should only move the render time on when the render changes", func() {
+		render()
+
+		rendered := metav1.NewTime(parent.Status.LastRenderTime.Add(-time.Minute))
+		parent.Status.LastRenderTime = &rendered
+		hash := parent.Status.LastRenderHash
+
+		render()
+		Expect(parent.Status.LastRenderHash).To(Equal(hash))
+		Expect(parent.Status.LastRenderTime).To(Equal(&rendered))
+
+		members.Certifications = []resumesv1alpha1.Certification{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cka", Namespace: "resumes"},
+				Spec:       resumesv1alpha1.CertificationSpec{Title: "CKA"},
+			},
+		}
+
+		render()
+		Expect(parent.Status.LastRenderHash).NotTo(Equal(hash))
+		Expect(parent.Status.LastRenderTime.After(rendered.Time)).To(BeTrue())
+		Expect(parent.Status.CertificationCount).To(Equal(int32(1)))
+	})
+
+	It("should report a PDF which cuts off the resume", func() {
+		render()
+
+		condition := meta.FindStatusCondition(parent.Status.RenderConditions, resumesv1beta1.ConditionPdfFits)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.ObservedGeneration).To(Equal(int64(3)))
+
+		highlights := []resumesv1alpha1.TaggedItem{}
+		for i := 0; i < 200; i++ {
+			highlights = append(highlights, resumesv1alpha1.TaggedItem{Text: fmt.Sprintf("Highlight number %d of the position", i)})
+		}
+
+		members.JobExperiences[0].Spec.Positions = []resumesv1alpha1.JobExperienceSpecPosition{{Title: "Engineer", Highlights: highlights}}
+
+		render()
+
+		condition = meta.FindStatusCondition(parent.Status.RenderConditions, resumesv1beta1.ConditionPdfFits)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ContentCutOff"))
+		Expect(condition.Message).To(ContainSubstring("more than its pageCount of 1"))
+	})
+})
@@ -0,0 +1,116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Tailoring", func() {
+	const positionYAML = `title: SRE
+highlights:
+  - Wrote docs
+  - {text: Managed a team, tags: [management]}
+  - {text: Built the platform, tags: [Platform], priority: 1}
+  - {text: Kept quiet, hidden: true}
+  - {text: Ran Kubernetes, tags: [platform], priority: 2}
+`
+
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+	)
+
+	BeforeEach(func() {
+		var position resumesv1alpha1.JobExperienceSpecPosition
+		Expect(yaml.Unmarshal([]byte(positionYAML), &position)).To(Succeed())
+
+		parent = &resumesv1beta1.Profile{}
+		parent.Spec.Web.Renderer = resumesv1beta1.RendererHugo
+		parent.Spec.Profile.Skills = []resumesv1beta1.ProfileSpecSkillFamily{
+			{
+				Family: "Languages",
+				Items: []resumesv1beta1.TaggedItem{
+					{Text: "Bash"},
+					{Text: "Go", Tags: []string{"platform"}},
+					{Text: "Excel", Tags: []string{"management"}},
+				},
+			},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{Spec: resumesv1alpha1.JobExperienceSpec{Positions: []resumesv1alpha1.JobExperienceSpecPosition{position}}},
+			},
+		}
+	})
+
+	It("should read items written as plain text or as objects, and write plain items as text", func() {
+		highlights := members.JobExperiences[0].Spec.Positions[0].Highlights
+		Expect(highlights).To(HaveLen(5))
+		Expect(highlights[0]).To(Equal(resumesv1alpha1.TaggedItem{Text: "Wrote docs"}))
+		Expect(highlights[2]).To(Equal(resumesv1alpha1.TaggedItem{Text: "Built the platform", Tags: []string{"Platform"}, Priority: 1}))
+
+		written, err := yaml.Marshal(highlights[:2])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(written)).To(Equal("- Wrote docs\n- tags:\n  - management\n  text: Managed a team\n"))
+	})
+
+	It("should leave out hidden items and order by priority without an audience", func() {
+		resume := Resume(parent, members)
+
+		Expect(resumesv1alpha1.ItemTexts(resume.JobExperiences[0].Spec.Positions[0].Highlights)).To(Equal([]string{
+			"Ran Kubernetes", "Built the platform", "Wrote docs", "Managed a team",
+		}))
+		Expect(resumesv1beta1.ItemTexts(resume.Profile.Spec.Profile.Skills[0].Items)).To(Equal([]string{"Bash", "Go", "Excel"}))
+	})
+
+	It("should filter and order items by the tags of the audience", func() {
+		parent.Spec.Audience = []string{"platform"}
+
+		resume := Resume(parent, members)
+
+		Expect(resumesv1alpha1.ItemTexts(resume.JobExperiences[0].Spec.Positions[0].Highlights)).To(Equal([]string{
+			"Ran Kubernetes", "Built the platform", "Wrote docs",
+		}))
+		Expect(resumesv1beta1.ItemTexts(resume.Profile.Spec.Profile.Skills[0].Items)).To(Equal([]string{"Go", "Bash"}))
+
+		// the collection and its members are not changed
+		Expect(parent.Spec.Profile.Skills[0].Items).To(HaveLen(3))
+		Expect(members.JobExperiences[0].Spec.Positions[0].Highlights).To(HaveLen(5))
+	})
+
+	It("should tailor the skills of the hugo profile data", func() {
+		parent.Spec.Audience = []string{"management"}
+
+		resources, err := CreateConfigMapResumeProfile(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(1))
+
+		data, _, _ := unstructured.NestedStringMap(resources[0].(*unstructured.Unstructured).Object, "data")
+		Expect(data["profile.yaml"]).To(ContainSubstring("- Excel"))
+		Expect(data["profile.yaml"]).To(ContainSubstring("- Bash"))
+		Expect(data["profile.yaml"]).NotTo(ContainSubstring("- Go"))
+	})
+})
@@ -0,0 +1,120 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"github.com/BurntSushi/toml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+var _ = Describe("Theme", func() {
+	var parent *resumesv1beta1.Profile
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				BaseURL:   "jane.example.com",
+				PageTitle: `Jane "JD" Doe \ CV`,
+				PageCount: 2,
+				Profile:   resumesv1beta1.ProfileSpecProfile{FirstName: "Jane", LastName: "Doe"},
+				Web:       resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererHugo},
+			},
+		}
+	})
+
+	// config decodes the config.toml of the resume-config ConfigMap.
+	config := func() hugoConfig {
+		resources, err := CreateConfigMapResumeConfig(parent, &Members{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(1))
+
+		data, _, _ := unstructured.NestedString(resources[0].(*unstructured.Unstructured).Object, "data", "config.toml")
+
+		var decoded hugoConfig
+		_, err = toml.Decode(data, &decoded)
+		Expect(err).NotTo(HaveOccurred())
+
+		return decoded
+	}
+
+	It("should write the colors of the default palette without a theme", func() {
+		decoded := config()
+
+		Expect(decoded.Title).To(Equal(`Jane "JD" Doe \ CV`))
+		Expect(decoded.BaseURL).To(Equal("https://jane.example.com/"))
+		Expect(decoded.Params.Pages).To(Equal(2))
+		Expect(decoded.Params.ColorPrimary).To(Equal("#4C7535"))
+		Expect(decoded.Params.ColorRightColumnIconBackground).To(Equal("#96B986"))
+		Expect(decoded.Params.FontBody).To(BeEmpty())
+	})
+
+	It("should write the colors of a palette with its overrides, and the fonts", func() {
+		parent.Spec.Theme = resumesv1beta1.ProfileSpecTheme{
+			Palette: "blue",
+			Colors:  resumesv1beta1.ProfileSpecThemeColors{Primary: "#123456"},
+			Fonts:   resumesv1beta1.ProfileSpecThemeFonts{Body: `"Open Sans", sans-serif`, Heading: "Lato"},
+		}
+
+		decoded := config()
+
+		Expect(decoded.Params.ColorPrimary).To(Equal("#123456"))
+		Expect(decoded.Params.ColorHeader).To(Equal(resumesv1beta1.Palettes["blue"].Header))
+		Expect(decoded.Params.FontBody).To(Equal(`"Open Sans", sans-serif`))
+		Expect(decoded.Params.FontHeading).To(Equal("Lato"))
+	})
+
+	It("should replace the hugo pods when the config changes", func() {
+		resources, err := CreateDeploymentResume(parent, &Members{})
+		Expect(err).NotTo(HaveOccurred())
+
+		before, _, _ := unstructured.NestedString(resources[0].(*unstructured.Unstructured).Object,
+			"spec", "template", "metadata", "annotations", ConfigChecksumAnnotation)
+		Expect(before).NotTo(BeEmpty())
+
+		parent.Spec.Theme.Palette = "slate"
+
+		resources, err = CreateDeploymentResume(parent, &Members{})
+		Expect(err).NotTo(HaveOccurred())
+
+		after, _, _ := unstructured.NestedString(resources[0].(*unstructured.Unstructured).Object,
+			"spec", "template", "metadata", "annotations", ConfigChecksumAnnotation)
+		Expect(after).NotTo(Equal(before))
+	})
+
+	It("should style the native page with the theme and ignore unsafe values", func() {
+		parent.Spec.Theme = resumesv1beta1.ProfileSpecTheme{
+			Colors: resumesv1beta1.ProfileSpecThemeColors{Primary: "#123456", Dark: "red; background: url(x)"},
+			Fonts:  resumesv1beta1.ProfileSpecThemeFonts{Body: "Lato; color: red"},
+		}
+
+		page, err := render.HTML(Resume(parent, &Members{}))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(string(page)).To(ContainSubstring("background: #123456;"))
+		Expect(string(page)).To(ContainSubstring("color: #666;"))
+		Expect(string(page)).To(ContainSubstring("font: 15px/1.5 'Helvetica Neue', Helvetica, Arial, sans-serif;"))
+		Expect(string(page)).NotTo(ContainSubstring("url(x)"))
+	})
+})
@@ -0,0 +1,232 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// VariantLabel is set on the child resources of a ResumeVariant with the name of the variant.
+const VariantLabel = "resumes.jefedavis.dev/variant"
+
+// VariantCreateFuncs are the functions of CreateFuncs which create the child resources of a
+// ResumeVariant.  A variant is served by the resume site of its collection, so only its page
+// and PDF are generated.
+var VariantCreateFuncs = []func(
+	*resumesv1beta1.Profile,
+	*Members,
+) ([]client.Object, error){
+	CreateConfigMapResumePdf,
+	CreateConfigMapResumeSite,
+}
+
+// GenerateVariant returns the child resources of a ResumeVariant.  They are generated by the
+// same functions as the resources of the resume, from the collection and members which are
+// selected by the variant, and are named after the variant.
+func GenerateVariant(
+	collectionObj resumesv1beta1.Profile,
+	members Members,
+	variant resumesv1alpha1.ResumeVariant,
+) ([]client.Object, error) {
+	parent, selected, err := Select(&collectionObj, &members, &variant)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceObjects, err := generate(VariantCreateFuncs, parent, selected)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resourceObject := range resourceObjects {
+		resourceObject.SetName(VariantResourceName(&variant, resourceObject.GetName()))
+
+		objectLabels := resourceObject.GetLabels()
+		objectLabels[VariantLabel] = variant.Name
+		resourceObject.SetLabels(objectLabels)
+	}
+
+	return resourceObjects, nil
+}
+
+// GenerateVariantForCLI returns the child resources of a ResumeVariant given appropriate
+// YAML manifest files.  The members of the collection are not read, so the variant selects
+// from the collection alone.
+func GenerateVariantForCLI(workloadFile []byte, collectionFile []byte) ([]client.Object, error) {
+	collectionObj, err := CollectionForCLI(collectionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	workloadObj, err := VariantForCLI(workloadFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return GenerateVariant(*collectionObj, Members{}, *workloadObj)
+}
+
+// VariantForCLI returns the ResumeVariant of a YAML manifest file.
+func VariantForCLI(workloadFile []byte) (*resumesv1alpha1.ResumeVariant, error) {
+	var workloadObj resumesv1alpha1.ResumeVariant
+	if err := yaml.Unmarshal(workloadFile, &workloadObj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal yaml into workload, %w", err)
+	}
+
+	if err := workload.Validate(&workloadObj); err != nil {
+		return nil, fmt.Errorf("error validating workload yaml, %w", err)
+	}
+
+	return &workloadObj, nil
+}
+
+// VariantResourceName returns the name of the child resource of a ResumeVariant which is
+// generated as the child resource of the resume with the given name.
+func VariantResourceName(variant *resumesv1alpha1.ResumeVariant, name string) string {
+	return name + "-" + variant.Name
+}
+
+// Select returns the collection and members which are selected by a ResumeVariant.  The
+// collection is always rendered by the native renderer, as the page of a variant is served
+// alongside the site of the collection rather than built by Hugo.
+func Select(
+	parent *resumesv1beta1.Profile,
+	members *Members,
+	variant *resumesv1alpha1.ResumeVariant,
+) (*resumesv1beta1.Profile, *Members, error) {
+	selectedParent := parent.DeepCopy()
+	selectedParent.Spec.Web.Renderer = resumesv1beta1.RendererNative
+
+	if variant.Spec.PageTitle != "" {
+		selectedParent.Spec.PageTitle = variant.Spec.PageTitle
+	}
+
+	if len(variant.Spec.Audience) > 0 {
+		selectedParent.Spec.Audience = variant.Spec.Audience
+	}
+
+	if len(variant.Spec.SkillFamilies) > 0 {
+		selectedParent.Spec.Profile.Skills = nil
+
+		for _, family := range parent.Spec.Profile.Skills {
+			if containsFold(variant.Spec.SkillFamilies, family.Family) {
+				selectedParent.Spec.Profile.Skills = append(selectedParent.Spec.Profile.Skills, family)
+			}
+		}
+	}
+
+	selector := &variantSelector{}
+	selected := &Members{}
+
+	for i := range members.JobExperiences {
+		if selector.matches(variant.Spec.JobExperienceSelector, members.JobExperiences[i].Labels) {
+			member := members.JobExperiences[i].DeepCopy()
+
+			for j := range member.Spec.Positions {
+				member.Spec.Positions[j].Highlights = excludeHighlights(member.Spec.Positions[j].Highlights, variant.Spec.ExcludeHighlights)
+			}
+
+			selected.JobExperiences = append(selected.JobExperiences, *member)
+		}
+	}
+
+	for i := range members.Certifications {
+		if selector.matches(variant.Spec.CertificationSelector, members.Certifications[i].Labels) {
+			selected.Certifications = append(selected.Certifications, members.Certifications[i])
+		}
+	}
+
+	for i := range members.Educations {
+		if selector.matches(variant.Spec.EducationSelector, members.Educations[i].Labels) {
+			selected.Educations = append(selected.Educations, members.Educations[i])
+		}
+	}
+
+	for i := range members.Projects {
+		if selector.matches(variant.Spec.ProjectSelector, members.Projects[i].Labels) {
+			selected.Projects = append(selected.Projects, members.Projects[i])
+		}
+	}
+
+	if selector.err != nil {
+		return nil, nil, fmt.Errorf("unable to select members for ResumeVariant %s, %w", variant.Name, selector.err)
+	}
+
+	return selectedParent, selected, nil
+}
+
+// variantSelector matches the labels of members against the selectors of a variant, holding
+// the first error which occurs.
+type variantSelector struct {
+	err error
+}
+
+// matches determines if a member with the given labels is selected.  A member is selected
+// when there is no selector.
+func (s *variantSelector) matches(labelSelector *metav1.LabelSelector, memberLabels map[string]string) bool {
+	if labelSelector == nil || s.err != nil {
+		return s.err == nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		s.err = err
+
+		return false
+	}
+
+	return selector.Matches(labels.Set(memberLabels))
+}
+
+// excludeHighlights returns the highlights without those which are excluded by their text.
+func excludeHighlights(highlights []resumesv1alpha1.TaggedItem, excluded []string) []resumesv1alpha1.TaggedItem {
+	if len(excluded) == 0 {
+		return highlights
+	}
+
+	kept := []resumesv1alpha1.TaggedItem{}
+
+	for _, highlight := range highlights {
+		if !containsFold(excluded, strings.TrimSpace(highlight.Text)) {
+			kept = append(kept, highlight)
+		}
+	}
+
+	return kept
+}
+
+// containsFold determines if a list contains a value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("ResumeVariant", func() {
+	var (
+		parent  *resumesv1beta1.Profile
+		members *Members
+		variant *resumesv1alpha1.ResumeVariant
+	)
+
+	BeforeEach(func() {
+		parent = &resumesv1beta1.Profile{
+			ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "resumes"},
+			Spec: resumesv1beta1.ProfileSpec{
+				PageTitle: "Jane Doe - CV",
+				Profile: resumesv1beta1.ProfileSpecProfile{
+					FirstName: "Jane",
+					LastName:  "Doe",
+					Skills: []resumesv1beta1.ProfileSpecSkillFamily{
+						{Family: "Languages", Items: resumesv1beta1.TextItems("Go")},
+						{Family: "Cloud Native", Items: resumesv1beta1.TextItems("Kubernetes")},
+					},
+				},
+				Web: resumesv1beta1.ProfileSpecWeb{Renderer: resumesv1beta1.RendererHugo},
+			},
+		}
+
+		members = &Members{
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "acme", Labels: map[string]string{"track": "platform"}},
+					Spec: resumesv1alpha1.JobExperienceSpec{
+						Employer:  "Acme",
+						StartDate: "2020-01",
+						EndDate:   resumesv1alpha1.DatePresent,
+						Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+							{
+								Title:      "SRE",
+								StartDate:  "2020-01",
+								EndDate:    resumesv1alpha1.DatePresent,
+								Highlights: resumesv1alpha1.TextItems("Built the platform", "Fixed the printer"),
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "initech"},
+					Spec:       resumesv1alpha1.JobExperienceSpec{Employer: "Initech", StartDate: "2015-01", EndDate: "2019-12"},
+				},
+			},
+			Certifications: []resumesv1alpha1.Certification{
+				{ObjectMeta: metav1.ObjectMeta{Name: "cka"}, Spec: resumesv1alpha1.CertificationSpec{Title: "CKA", Alias: "cka"}},
+			},
+		}
+
+		variant = &resumesv1alpha1.ResumeVariant{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "resumes"},
+			Spec: resumesv1alpha1.ResumeVariantSpec{
+				PageTitle:             "Jane Doe - Platform Engineer",
+				JobExperienceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"track": "platform"}},
+				SkillFamilies:         []string{"cloud native"},
+				ExcludeHighlights:     []string{"Fixed the printer"},
+			},
+		}
+	})
+
+	It("should select the members and parts of the profile of a variant", func() {
+		selectedParent, selected, err := Select(parent, members, variant)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(selectedParent.Spec.PageTitle).To(Equal("Jane Doe - Platform Engineer"))
+		Expect(selectedParent.Spec.Web.Renderer).To(Equal(resumesv1beta1.RendererNative))
+		Expect(selectedParent.Spec.Profile.Skills).To(Equal([]resumesv1beta1.ProfileSpecSkillFamily{
+			{Family: "Cloud Native", Items: resumesv1beta1.TextItems("Kubernetes")},
+		}))
+
+		Expect(selected.JobExperiences).To(HaveLen(1))
+		Expect(selected.JobExperiences[0].Name).To(Equal("acme"))
+		Expect(selected.JobExperiences[0].Spec.Positions[0].Highlights).To(Equal(resumesv1alpha1.TextItems("Built the platform")))
+
+		// members without a selector are all selected
+		Expect(selected.Certifications).To(HaveLen(1))
+	})
+
+	It("should not change the collection or its members", func() {
+		_, _, err := Select(parent, members, variant)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(parent.Spec.Web.Renderer).To(Equal(resumesv1beta1.RendererHugo))
+		Expect(parent.Spec.Profile.Skills).To(HaveLen(2))
+		Expect(members.JobExperiences[0].Spec.Positions[0].Highlights).To(HaveLen(2))
+	})
+
+	It("should reject an invalid selector", func() {
+		variant.Spec.CertificationSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "track", Operator: "Near"}},
+		}
+
+		_, _, err := Select(parent, members, variant)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should generate the page and PDF of a variant named after the variant", func() {
+		resources, err := GenerateVariant(*parent, *members, *variant)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(2))
+
+		names := []string{}
+
+		for _, resource := range resources {
+			names = append(names, resource.GetName())
+			Expect(resource.GetNamespace()).To(Equal("resumes"))
+			Expect(resource.GetLabels()).To(HaveKeyWithValue(VariantLabel, "platform"))
+		}
+
+		Expect(names).To(ConsistOf("jane-resume-pdf-platform", "jane-resume-site-platform"))
+	})
+
+	It("should generate a variant from its manifest files", func() {
+		resources, err := GenerateVariantForCLI([]byte(`apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: ResumeVariant
+metadata:
+  name: platform
+  namespace: resumes
+spec:
+  pageTitle: Jane Doe - Platform Engineer
+`), []byte(`apiVersion: resumes.jefedavis.dev/v1beta1
+kind: Profile
+metadata:
+  name: jane
+  namespace: resumes
+spec:
+  profile:
+    firstName: Jane
+    lastName: Doe
+`))
+		Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, resource := range resources {
+			names = append(names, resource.GetName())
+		}
+
+		Expect(names).To(ConsistOf("jane-resume-pdf-platform", "jane-resume-site-platform"))
+	})
+
+	It("should serve each variant within its path", func() {
+		parent.Spec.Web.Renderer = resumesv1beta1.RendererNative
+		members.Variants = []resumesv1alpha1.ResumeVariant{*variant}
+
+		resources, err := CreateDeploymentResumeServer(parent, members)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(HaveLen(1))
+
+		deployment, ok := resources[0].(*unstructured.Unstructured)
+		Expect(ok).To(BeTrue())
+
+		volumes, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+		sources, _, _ := unstructured.NestedSlice(volumes[0].(map[string]interface{}), "projected", "sources")
+		Expect(sources).To(ContainElement(configMapItemSource("jane-resume-site-platform", "index.html", "platform/index.html")))
+		Expect(sources).To(ContainElement(configMapItemSource("jane-resume-pdf-platform", "resume.pdf", "platform/resume.pdf")))
+	})
+})
@@ -0,0 +1,152 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// TaggedItem is an item of a list on a resume, such as a skill, which may be tagged with the
+// audiences that it is relevant to.  It is written either as plain text, or as an object with
+// the text along with its tags, priority and whether it is hidden.  An item with nothing but
+// text is written back as plain text, so that existing manifests do not change.
+// +kubebuilder:validation:Type=""
+// +kubebuilder:pruning:PreserveUnknownFields
+type TaggedItem struct {
+	// +kubebuilder:validation:Required
+	// The text of the item as it is rendered.
+	Text string `json:"text"`
+
+	// +kubebuilder:validation:Optional
+	// The audiences which the item is relevant to, e.g. "platform" or "management".
+	Tags []string `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: 0) Items with a higher priority are rendered before those with a lower one.
+	Priority int `json:"priority,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// (Default: false) A hidden item is kept on the manifest but is not rendered.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// taggedItem is the object form of a TaggedItem.
+type taggedItem TaggedItem
+
+// UnmarshalJSON reads an item which is written either as plain text or as an object.
+func (item *TaggedItem) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '"' {
+		*item = TaggedItem{}
+
+		return json.Unmarshal(data, &item.Text)
+	}
+
+	var object taggedItem
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	*item = TaggedItem(object)
+
+	return nil
+}
+
+// MarshalJSON writes an item with nothing but text as plain text, and any other item as an
+// object.
+func (item TaggedItem) MarshalJSON() ([]byte, error) {
+	if len(item.Tags) == 0 && item.Priority == 0 && !item.Hidden {
+		return json.Marshal(item.Text)
+	}
+
+	return json.Marshal(taggedItem(item))
+}
+
+// String returns the text of the item, so that an item is rendered as its text.
+func (item TaggedItem) String() string {
+	return item.Text
+}
+
+// HasTag returns whether the item is tagged with any of the given tags, ignoring case.
+func (item TaggedItem) HasTag(tags []string) bool {
+	for _, tag := range item.Tags {
+		for _, other := range tags {
+			if strings.EqualFold(strings.TrimSpace(tag), strings.TrimSpace(other)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// TextItems returns plain text items.
+func TextItems(texts ...string) []TaggedItem {
+	items := make([]TaggedItem, 0, len(texts))
+
+	for _, text := range texts {
+		items = append(items, TaggedItem{Text: text})
+	}
+
+	return items
+}
+
+// ItemTexts returns the text of each item.
+func ItemTexts(items []TaggedItem) []string {
+	texts := make([]string, 0, len(items))
+
+	for _, item := range items {
+		texts = append(texts, item.Text)
+	}
+
+	return texts
+}
+
+// TailorItems returns the items which are rendered for an audience, in the order in which
+// they are rendered.  A hidden item is never rendered.  When an audience is given, an item
+// which is tagged for none of the audience is left out, and an item which is tagged for it
+// is rendered before an untagged item.  Items are otherwise rendered by priority, highest
+// first, and then in the order in which they are listed.
+func TailorItems(items []TaggedItem, audience []string) []TaggedItem {
+	tailored := []TaggedItem{}
+
+	for _, item := range items {
+		if item.Hidden {
+			continue
+		}
+
+		if len(audience) > 0 && len(item.Tags) > 0 && !item.HasTag(audience) {
+			continue
+		}
+
+		tailored = append(tailored, item)
+	}
+
+	sort.SliceStable(tailored, func(i, j int) bool {
+		if len(audience) > 0 {
+			if relevant := tailored[i].HasTag(audience); relevant != tailored[j].HasTag(audience) {
+				return relevant
+			}
+		}
+
+		return tailored[i].Priority > tailored[j].Priority
+	})
+
+	return tailored
+}
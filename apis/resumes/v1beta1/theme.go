@@ -0,0 +1,129 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// Palettes are the named palettes which the colors of a theme are taken from.  The green
+// palette holds the colors which the site was built with before themes could be set.
+var Palettes = map[string]ProfileSpecThemeColors{
+	"green": {
+		Light:                     "#fff",
+		Dark:                      "#666",
+		PageBackground:            "#ddd",
+		Primary:                   "#4C7535",
+		Secondary:                 "#68B3C2",
+		Header:                    "#3E762A",
+		Header2:                   "#33779D",
+		IconPrimary:               "#fff",
+		IconBackground:            "#96B986",
+		RightColumnBackground:     "#f5f5f5",
+		RightColumnHeadingText:    "#4C7535",
+		RightColumnBodyText:       "#666",
+		RightColumnIconPrimary:    "#fff",
+		RightColumnIconBackground: "#96B986",
+	},
+	"blue": {
+		Light:                     "#fff",
+		Dark:                      "#555",
+		PageBackground:            "#dde3ea",
+		Primary:                   "#1F4E79",
+		Secondary:                 "#5B9BD5",
+		Header:                    "#1F4E79",
+		Header2:                   "#2E75B6",
+		IconPrimary:               "#fff",
+		IconBackground:            "#7FA7CF",
+		RightColumnBackground:     "#f3f6f9",
+		RightColumnHeadingText:    "#1F4E79",
+		RightColumnBodyText:       "#555",
+		RightColumnIconPrimary:    "#fff",
+		RightColumnIconBackground: "#7FA7CF",
+	},
+	"slate": {
+		Light:                     "#fff",
+		Dark:                      "#444",
+		PageBackground:            "#e0e0e0",
+		Primary:                   "#37474F",
+		Secondary:                 "#78909C",
+		Header:                    "#263238",
+		Header2:                   "#546E7A",
+		IconPrimary:               "#fff",
+		IconBackground:            "#90A4AE",
+		RightColumnBackground:     "#f5f5f5",
+		RightColumnHeadingText:    "#37474F",
+		RightColumnBodyText:       "#444",
+		RightColumnIconPrimary:    "#fff",
+		RightColumnIconBackground: "#90A4AE",
+	},
+	"burgundy": {
+		Light:                     "#fff",
+		Dark:                      "#5a5a5a",
+		PageBackground:            "#e6dcdc",
+		Primary:                   "#7B2D3B",
+		Secondary:                 "#C08497",
+		Header:                    "#6B1F2E",
+		Header2:                   "#9E4A5A",
+		IconPrimary:               "#fff",
+		IconBackground:            "#C99AA4",
+		RightColumnBackground:     "#f8f4f4",
+		RightColumnHeadingText:    "#7B2D3B",
+		RightColumnBodyText:       "#5a5a5a",
+		RightColumnIconPrimary:    "#fff",
+		RightColumnIconBackground: "#C99AA4",
+	},
+}
+
+// ResolvedColors returns the colors of the theme: those of its palette, with the colors which
+// are set on the theme in their place.  The default palette is used when the palette is
+// unset or unknown.
+func (theme ProfileSpecTheme) ResolvedColors() ProfileSpecThemeColors {
+	palette, ok := Palettes[theme.Palette]
+	if !ok {
+		palette = Palettes[DefaultPalette]
+	}
+
+	resolved := palette
+	overrides := theme.Colors
+
+	resolvedFields := resolved.fields()
+
+	for i, override := range overrides.fields() {
+		if *override != "" {
+			*resolvedFields[i] = *override
+		}
+	}
+
+	return resolved
+}
+
+// fields returns each of the colors, in the order which they are declared.
+func (colors *ProfileSpecThemeColors) fields() []*string {
+	return []*string{
+		&colors.Light,
+		&colors.Dark,
+		&colors.PageBackground,
+		&colors.Primary,
+		&colors.Secondary,
+		&colors.Header,
+		&colors.Header2,
+		&colors.IconPrimary,
+		&colors.IconBackground,
+		&colors.RightColumnBackground,
+		&colors.RightColumnHeadingText,
+		&colors.RightColumnBodyText,
+		&colors.RightColumnIconPrimary,
+		&colors.RightColumnIconBackground,
+	}
+}
@@ -0,0 +1,432 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/nukleros/operator-builder-tools/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Profile) DeepCopyInto(out *Profile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Profile.
+func (in *Profile) DeepCopy() *Profile {
+	if in == nil {
+		return nil
+	}
+	out := new(Profile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Profile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileList) DeepCopyInto(out *ProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Profile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileList.
+func (in *ProfileList) DeepCopy() *ProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpec) DeepCopyInto(out *ProfileSpec) {
+	*out = *in
+	in.Profile.DeepCopyInto(&out.Profile)
+	out.Web = in.Web
+	out.Theme = in.Theme
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Pdf = in.Pdf
+	if in.MemberSelector != nil {
+		in, out := &in.MemberSelector, &out.MemberSelector
+		*out = new(ProfileSpecMemberSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpec.
+func (in *ProfileSpec) DeepCopy() *ProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecMemberSelector) DeepCopyInto(out *ProfileSpecMemberSelector) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecMemberSelector.
+func (in *ProfileSpecMemberSelector) DeepCopy() *ProfileSpecMemberSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecMemberSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecPdf) DeepCopyInto(out *ProfileSpecPdf) {
+	*out = *in
+	out.Image = in.Image
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecPdf.
+func (in *ProfileSpecPdf) DeepCopy() *ProfileSpecPdf {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecPdf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecPdfImage) DeepCopyInto(out *ProfileSpecPdfImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecPdfImage.
+func (in *ProfileSpecPdfImage) DeepCopy() *ProfileSpecPdfImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecPdfImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecProfile) DeepCopyInto(out *ProfileSpecProfile) {
+	*out = *in
+	if in.CoreCompetencies != nil {
+		in, out := &in.CoreCompetencies, &out.CoreCompetencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]ProfileSpecProject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Skills != nil {
+		in, out := &in.Skills, &out.Skills
+		*out = make([]ProfileSpecSkillFamily, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecProfile.
+func (in *ProfileSpecProfile) DeepCopy() *ProfileSpecProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecProject) DeepCopyInto(out *ProfileSpecProject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecProject.
+func (in *ProfileSpecProject) DeepCopy() *ProfileSpecProject {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecSkillFamily) DeepCopyInto(out *ProfileSpecSkillFamily) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TaggedItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecSkillFamily.
+func (in *ProfileSpecSkillFamily) DeepCopy() *ProfileSpecSkillFamily {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecSkillFamily)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecTheme) DeepCopyInto(out *ProfileSpecTheme) {
+	*out = *in
+	out.Colors = in.Colors
+	out.Fonts = in.Fonts
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecTheme.
+func (in *ProfileSpecTheme) DeepCopy() *ProfileSpecTheme {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecTheme)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecThemeColors) DeepCopyInto(out *ProfileSpecThemeColors) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecThemeColors.
+func (in *ProfileSpecThemeColors) DeepCopy() *ProfileSpecThemeColors {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecThemeColors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecThemeFonts) DeepCopyInto(out *ProfileSpecThemeFonts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecThemeFonts.
+func (in *ProfileSpecThemeFonts) DeepCopy() *ProfileSpecThemeFonts {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecThemeFonts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecWeb) DeepCopyInto(out *ProfileSpecWeb) {
+	*out = *in
+	out.Image = in.Image
+	out.Server = in.Server
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecWeb.
+func (in *ProfileSpecWeb) DeepCopy() *ProfileSpecWeb {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecWeb)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecWebImage) DeepCopyInto(out *ProfileSpecWebImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecWebImage.
+func (in *ProfileSpecWebImage) DeepCopy() *ProfileSpecWebImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecWebImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecWebServer) DeepCopyInto(out *ProfileSpecWebServer) {
+	*out = *in
+	out.Image = in.Image
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecWebServer.
+func (in *ProfileSpecWebServer) DeepCopy() *ProfileSpecWebServer {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecWebServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpecWebServerImage) DeepCopyInto(out *ProfileSpecWebServerImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpecWebServerImage.
+func (in *ProfileSpecWebServerImage) DeepCopy() *ProfileSpecWebServerImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpecWebServerImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileStatus) DeepCopyInto(out *ProfileStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*status.PhaseCondition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.PhaseCondition)
+				**out = **in
+			}
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*status.ChildResource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(status.ChildResource)
+				**out = **in
+			}
+		}
+	}
+	if in.ReadinessConditions != nil {
+		in, out := &in.ReadinessConditions, &out.ReadinessConditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RenderConditions != nil {
+		in, out := &in.RenderConditions, &out.RenderConditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRenderTime != nil {
+		in, out := &in.LastRenderTime, &out.LastRenderTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStatus.
+func (in *ProfileStatus) DeepCopy() *ProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaggedItem) DeepCopyInto(out *TaggedItem) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaggedItem.
+func (in *TaggedItem) DeepCopy() *TaggedItem {
+	if in == nil {
+		return nil
+	}
+	out := new(TaggedItem)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+	"github.com/jefedavis/resume-operator/cmd/resumectl/commands/manifests"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+type ExportSubCommand struct {
+	*cobra.Command
+}
+
+// NewBaseExportSubCommand returns a subcommand that is meant to belong to a parent
+// subcommand but have subcommands itself, one for each format which may be exported.
+func NewBaseExportSubCommand(parentCommand *cobra.Command) *ExportSubCommand {
+	exportCmd := &ExportSubCommand{
+		Command: &cobra.Command{
+			Use:   "export",
+			Short: "export a resume to another format from profile collection and component manifests or a cluster",
+			Long:  "export a resume to another format from profile collection and component manifests or a cluster",
+		},
+	}
+
+	parentCommand.AddCommand(exportCmd.Command)
+
+	return exportCmd
+}
+
+// Source is where a resume is exported from: the manifests of a profile collection and its
+// components when the profile manifest is set, or the cluster otherwise.
+type Source struct {
+	manifests.Files
+
+	Kubeconfig string
+	Context    string
+	Name       string
+	Namespace  string
+}
+
+// AddFlags adds the flags which set the source of the resume to a command.
+func (s *Source) AddFlags(command *cobra.Command) {
+	s.Files.AddFlags(command)
+
+	command.Flags().StringVarP(&s.Kubeconfig, "kubeconfig", "", "", "filepath to the kubeconfig of the cluster to export from when no profile manifest is given")
+	command.Flags().StringVarP(&s.Context, "context", "", "", "kubeconfig context of the cluster to export from")
+	command.Flags().StringVarP(&s.Name, "name", "", "", "name of the Profile collection in the cluster, the only collection if unset")
+	command.Flags().StringVarP(&s.Namespace, "namespace", "n", "", "namespace of the Profile collection in the cluster, the kubeconfig namespace if unset")
+}
+
+// Resume returns the resume of the source.
+func (s *Source) Resume(ctx context.Context) (*render.Resume, error) {
+	var (
+		profile *resumesv1beta1.Profile
+		members *v1beta1profile.Members
+		err     error
+	)
+
+	if s.ProfileManifest != "" {
+		profile, members, err = s.Files.Read()
+	} else {
+		profile, members, err = s.readCluster(ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return v1beta1profile.Resume(profile, members), nil
+}
+
+// readCluster reads the Profile collection and the components which belong to it from the
+// cluster, finding them as the controller does.
+func (s *Source) readCluster(ctx context.Context) (*resumesv1beta1.Profile, *v1beta1profile.Members, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = s.Kubeconfig
+
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: s.Context},
+	)
+
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load kubeconfig, %w", err)
+	}
+
+	namespace := s.Namespace
+	if namespace == "" && s.Name != "" {
+		if namespace, _, err = clientConfig.Namespace(); err != nil {
+			return nil, nil, fmt.Errorf("unable to determine namespace from kubeconfig, %w", err)
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	if err := resumesv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, nil, fmt.Errorf("unable to add resumes/v1alpha1 to scheme, %w", err)
+	}
+
+	if err := resumesv1beta1.AddToScheme(scheme); err != nil {
+		return nil, nil, fmt.Errorf("unable to add resumes/v1beta1 to scheme, %w", err)
+	}
+
+	reader, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create client, %w", err)
+	}
+
+	profile, err := v1beta1profile.GetCollection(ctx, reader, s.Name, namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get Profile collection, %w", err)
+	}
+
+	members, err := v1beta1profile.ListMembers(ctx, reader, profile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to list members of Profile collection %s/%s, %w", profile.Namespace, profile.Name, err)
+	}
+
+	return profile, members, nil
+}
+
+// writeOutput writes a document to the output, or to standard out if the output is unset.
+func writeOutput(document []byte, output string) error {
+	if output == "" {
+		if _, err := os.Stdout.Write(document); err != nil {
+			return fmt.Errorf("failed to write output, %w", err)
+		}
+
+		return nil
+	}
+
+	if err := os.WriteFile(output, document, 0o644); err != nil {
+		return fmt.Errorf("failed to write output file %s, %w", output, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jefedavis/resume-operator/internal/jsonresume"
+)
+
+type JSONResumeSubCommand struct {
+	*cobra.Command
+
+	// flags
+	Source
+	Output string
+}
+
+// NewJSONResumeSubCommand creates a new command and adds it to its parent command.
+func NewJSONResumeSubCommand(parentCommand *cobra.Command) *JSONResumeSubCommand {
+	jsonResumeCmd := &JSONResumeSubCommand{}
+
+	jsonResumeCmd.Setup()
+	parentCommand.AddCommand(jsonResumeCmd.Command)
+
+	return jsonResumeCmd
+}
+
+// Setup sets up this command to be used as a command.
+func (j *JSONResumeSubCommand) Setup() {
+	j.Command = &cobra.Command{
+		Use:   "jsonresume",
+		Short: "export a resume as a JSON Resume (https://jsonresume.org)",
+		Long: "export a resume as a JSON Resume (https://jsonresume.org) from a Profile collection and its " +
+			"component manifests, or from a Profile collection and its components in a cluster",
+		RunE: j.exportJSONResume,
+	}
+
+	j.Source.AddFlags(j.Command)
+	j.Flags().StringVarP(&j.Output, "output", "o", "", "filepath to write the JSON Resume to, standard out if unset")
+}
+
+// exportJSONResume exports the resume as a JSON Resume and writes it to the output.
+func (j *JSONResumeSubCommand) exportJSONResume(cmd *cobra.Command, args []string) error {
+	resume, err := j.Resume(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	document, err := json.MarshalIndent(jsonresume.Export(resume), "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal JSON Resume, %w", err)
+	}
+
+	return writeOutput(append(document, '\n'), j.Output)
+}
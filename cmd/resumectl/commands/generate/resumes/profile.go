@@ -32,6 +32,7 @@ import (
 	cmdgenerate "github.com/jefedavis/resume-operator/cmd/resumectl/commands/generate"
 	// specific imports for workloads
 	v1alpha1profile "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/resume"
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 	//+kubebuilder:scaffold:operator-builder:imports
 )
 
@@ -75,6 +76,7 @@ func GenerateProfile(g *cmdgenerate.GenerateSubCommand) error {
 	type generateFunc func([]byte) ([]client.Object, error)
 	generateFuncMap := map[string]generateFunc{
 		"v1alpha1": v1alpha1profile.GenerateForCLI,
+		"v1beta1":  v1beta1profile.GenerateForCLI,
 		//+kubebuilder:scaffold:operator-builder:versionmap
 	}
 
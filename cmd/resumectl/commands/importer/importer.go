@@ -0,0 +1,84 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type ImportSubCommand struct {
+	*cobra.Command
+}
+
+// NewBaseImportSubCommand returns a subcommand that is meant to belong to a parent
+// subcommand but have subcommands itself, one for each format which may be imported.
+func NewBaseImportSubCommand(parentCommand *cobra.Command) *ImportSubCommand {
+	importCmd := &ImportSubCommand{
+		Command: &cobra.Command{
+			Use:   "import",
+			Short: "import a resume from another format as profile collection and component manifests",
+			Long:  "import a resume from another format as profile collection and component manifests",
+		},
+	}
+
+	parentCommand.AddCommand(importCmd.Command)
+
+	return importCmd
+}
+
+// writeManifests writes the manifests as a multi-document YAML stream to the output, or to
+// standard out if the output is unset, so that they may be applied with kubectl.
+func writeManifests(objects []client.Object, output string) error {
+	var outputStream io.Writer = os.Stdout
+
+	if output != "" {
+		outputFile, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file %s, %w", output, err)
+		}
+
+		defer outputFile.Close()
+
+		outputStream = outputFile
+	}
+
+	for _, o := range objects {
+		manifest, err := yaml.Marshal(o)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s, %w", o.GetObjectKind().GroupVersionKind().Kind, o.GetName(), err)
+		}
+
+		if _, err := io.WriteString(outputStream, "---\n"+string(manifest)); err != nil {
+			return fmt.Errorf("failed to write output, %w", err)
+		}
+	}
+
+	return nil
+}
+
+// warn writes the warnings of an import to standard error.
+func warn(warnings []string) {
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jefedavis/resume-operator/internal/jsonresume"
+)
+
+type JSONResumeSubCommand struct {
+	*cobra.Command
+
+	// flags
+	Name      string
+	Namespace string
+	Output    string
+}
+
+// NewJSONResumeSubCommand creates a new command and adds it to its parent command.
+func NewJSONResumeSubCommand(parentCommand *cobra.Command) *JSONResumeSubCommand {
+	jsonResumeCmd := &JSONResumeSubCommand{}
+
+	jsonResumeCmd.Setup()
+	parentCommand.AddCommand(jsonResumeCmd.Command)
+
+	return jsonResumeCmd
+}
+
+// Setup sets up this command to be used as a command.
+func (j *JSONResumeSubCommand) Setup() {
+	j.Command = &cobra.Command{
+		Use:   "jsonresume RESUME_JSON",
+		Short: "import a JSON Resume (https://jsonresume.org) as profile collection and component manifests",
+		Long: "import a JSON Resume (https://jsonresume.org) as a Profile collection manifest along with a " +
+			"JobExperience manifest for each employer, a Certification manifest for each certificate and an " +
+			"Education manifest for each school, ready to be applied with kubectl",
+		Args: cobra.ExactArgs(1),
+		RunE: j.importJSONResume,
+	}
+
+	j.Flags().StringVarP(&j.Name, "name", "", "", "name of the Profile collection, derived from the name on the resume if unset")
+	j.Flags().StringVarP(&j.Namespace, "namespace", "n", "default", "namespace of the Profile collection and its components")
+	j.Flags().StringVarP(&j.Output, "output", "o", "", "filepath to write the manifests to, standard out if unset")
+}
+
+// importJSONResume imports the JSON Resume and writes its manifests to the output.
+func (j *JSONResumeSubCommand) importJSONResume(cmd *cobra.Command, args []string) error {
+	resumeFile, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open resume file %s, %w", args[0], err)
+	}
+
+	resume, err := jsonresume.Parse(resumeFile)
+	if err != nil {
+		return fmt.Errorf("failed to parse resume file %s, %w", args[0], err)
+	}
+
+	manifests := jsonresume.Import(resume, j.Name, j.Namespace)
+
+	warn(manifests.Warnings)
+
+	return writeManifests(manifests.Objects(), j.Output)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"archive/zip"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jefedavis/resume-operator/internal/linkedin"
+)
+
+type LinkedInSubCommand struct {
+	*cobra.Command
+
+	// flags
+	Name      string
+	Namespace string
+	Output    string
+}
+
+// NewLinkedInSubCommand creates a new command and adds it to its parent command.
+func NewLinkedInSubCommand(parentCommand *cobra.Command) *LinkedInSubCommand {
+	linkedInCmd := &LinkedInSubCommand{}
+
+	linkedInCmd.Setup()
+	parentCommand.AddCommand(linkedInCmd.Command)
+
+	return linkedInCmd
+}
+
+// Setup sets up this command to be used as a command.
+func (l *LinkedInSubCommand) Setup() {
+	l.Command = &cobra.Command{
+		Use:   "linkedin ARCHIVE_ZIP",
+		Short: "import a LinkedIn data export archive as profile collection and component manifests",
+		Long: "import a LinkedIn data export archive (\"Get a copy of your data\") as a Profile collection manifest " +
+			"along with a JobExperience manifest for each run of consecutive positions at a company, a Certification " +
+			"manifest for each certification and an Education manifest for each school, ready to be applied with kubectl",
+		Args: cobra.ExactArgs(1),
+		RunE: l.importLinkedIn,
+	}
+
+	l.Flags().StringVarP(&l.Name, "name", "", "", "name of the Profile collection, derived from the name on the profile if unset")
+	l.Flags().StringVarP(&l.Namespace, "namespace", "n", "default", "namespace of the Profile collection and its components")
+	l.Flags().StringVarP(&l.Output, "output", "o", "", "filepath to write the manifests to, standard out if unset")
+}
+
+// importLinkedIn imports the LinkedIn archive and writes its manifests to the output.
+func (l *LinkedInSubCommand) importLinkedIn(cmd *cobra.Command, args []string) error {
+	archive, err := zip.OpenReader(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open archive %s, %w", args[0], err)
+	}
+
+	defer archive.Close()
+
+	manifests, err := linkedin.Import(&archive.Reader, l.Name, l.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to import archive %s, %w", args[0], err)
+	}
+
+	warn(manifests.Warnings)
+
+	return writeManifests(manifests.Objects(), l.Output)
+}
@@ -34,7 +34,11 @@ import (
 func getCertificationManifest(i *cmdinit.InitSubCommand) (string, error) {
 	apiVersion := i.APIVersion
 	if apiVersion == "" || apiVersion == "latest" {
-		return resumes.CertificationLatestSample, nil
+		if !i.RequiredOnly {
+			return resumes.CertificationLatestSample, nil
+		}
+
+		apiVersion = resumes.CertificationLatestGroupVersion.Version
 	}
 
 	// generate a map of all versions to samples for each api version created
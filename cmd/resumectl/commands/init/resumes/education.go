@@ -0,0 +1,86 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resumes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jefedavis/resume-operator/apis/resumes"
+
+	v1alpha1education "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/education"
+	cmdinit "github.com/jefedavis/resume-operator/cmd/resumectl/commands/init"
+	//+kubebuilder:scaffold:operator-builder:imports
+)
+
+// getEducationManifest returns the sample Education manifest
+// based upon API Version input.
+func getEducationManifest(i *cmdinit.InitSubCommand) (string, error) {
+	apiVersion := i.APIVersion
+	if apiVersion == "" || apiVersion == "latest" {
+		if !i.RequiredOnly {
+			return resumes.EducationLatestSample, nil
+		}
+
+		apiVersion = resumes.EducationLatestGroupVersion.Version
+	}
+
+	// generate a map of all versions to samples for each api version created
+	manifestMap := map[string]string{
+		"v1alpha1": v1alpha1education.Sample(i.RequiredOnly),
+		//+kubebuilder:scaffold:operator-builder:versionmap
+	}
+
+	// return the manifest if it is not blank
+	manifest := manifestMap[apiVersion]
+	if manifest != "" {
+		return manifest, nil
+	}
+
+	// return an error if we did not find a manifest for an api version
+	return "", fmt.Errorf("unsupported API Version: " + apiVersion)
+}
+
+// NewEducationSubCommand creates a new command and adds it to its
+// parent command.
+func NewEducationSubCommand(parentCommand *cobra.Command) {
+	initCmd := &cmdinit.InitSubCommand{
+		Name:         "education",
+		Description:  "Manage resume education component",
+		InitFunc:     InitEducation,
+		SubCommandOf: parentCommand,
+	}
+
+	initCmd.Setup()
+}
+
+func InitEducation(i *cmdinit.InitSubCommand) error {
+	manifest, err := getEducationManifest(i)
+	if err != nil {
+		return fmt.Errorf("unable to get manifest for Education; %w", err)
+	}
+
+	outputStream := os.Stdout
+
+	if _, err := outputStream.WriteString(manifest); err != nil {
+		return fmt.Errorf("failed to write to stdout, %w", err)
+	}
+
+	return nil
+}
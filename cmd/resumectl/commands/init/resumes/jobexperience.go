@@ -34,7 +34,11 @@ import (
 func getJobExperienceManifest(i *cmdinit.InitSubCommand) (string, error) {
 	apiVersion := i.APIVersion
 	if apiVersion == "" || apiVersion == "latest" {
-		return resumes.JobExperienceLatestSample, nil
+		if !i.RequiredOnly {
+			return resumes.JobExperienceLatestSample, nil
+		}
+
+		apiVersion = resumes.JobExperienceLatestGroupVersion.Version
 	}
 
 	// generate a map of all versions to samples for each api version created
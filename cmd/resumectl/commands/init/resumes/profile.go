@@ -25,6 +25,7 @@ import (
 	"github.com/jefedavis/resume-operator/apis/resumes"
 
 	v1alpha1profile "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/resume"
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 	cmdinit "github.com/jefedavis/resume-operator/cmd/resumectl/commands/init"
 	//+kubebuilder:scaffold:operator-builder:imports
 )
@@ -34,12 +35,17 @@ import (
 func getProfileManifest(i *cmdinit.InitSubCommand) (string, error) {
 	apiVersion := i.APIVersion
 	if apiVersion == "" || apiVersion == "latest" {
-		return resumes.ProfileLatestSample, nil
+		if !i.RequiredOnly {
+			return resumes.ProfileLatestSample, nil
+		}
+
+		apiVersion = resumes.ProfileLatestGroupVersion.Version
 	}
 
 	// generate a map of all versions to samples for each api version created
 	manifestMap := map[string]string{
 		"v1alpha1": v1alpha1profile.Sample(i.RequiredOnly),
+		"v1beta1":  v1beta1profile.Sample(i.RequiredOnly),
 		//+kubebuilder:scaffold:operator-builder:versionmap
 	}
 
@@ -0,0 +1,274 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manifests reads a profile collection and its components from manifest files, for
+// the commands which work with a resume without a cluster.
+package manifests
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	v1alpha1profile "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/resume"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+)
+
+var (
+	ErrUnknownAPIVersion = errors.New("unknown api version")
+	ErrMissingManifest   = errors.New("missing manifest")
+)
+
+// Files are the filepaths of the manifests of a profile collection and its components.  The
+// components may each be a manifest file or a directory of manifest files.
+type Files struct {
+	ProfileManifest        string
+	ExperienceManifests    string
+	CertificationManifests string
+	EducationManifests     string
+	ProjectManifests       string
+}
+
+// AddFlags adds the flags which set the filepaths of the manifests to a command.
+func (f *Files) AddFlags(command *cobra.Command) {
+	command.Flags().StringVarP(&f.ProfileManifest, "profile", "p", "", "filepath to the Profile collection manifest")
+	command.Flags().StringVarP(&f.ExperienceManifests, "experience", "", "", "filepath to a JobExperience manifest or a directory of manifests")
+	command.Flags().StringVarP(&f.CertificationManifests, "certs", "", "", "filepath to a Certification manifest or a directory of manifests")
+	command.Flags().StringVarP(&f.EducationManifests, "education", "", "", "filepath to an Education manifest or a directory of manifests")
+	command.Flags().StringVarP(&f.ProjectManifests, "projects", "", "", "filepath to a Project manifest or a directory of manifests")
+}
+
+// Read reads the Profile collection from its manifest, along with the components in the
+// manifests of the components which belong to it.  The components are in the same order as
+// the controller would find them.
+func (f *Files) Read() (*resumesv1beta1.Profile, *v1beta1profile.Members, error) {
+	profile, err := readProfile(f.ProfileManifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members := &v1beta1profile.Members{}
+
+	if err := readMembers(f.ExperienceManifests, "JobExperience", func(document []byte) error {
+		var member resumesv1alpha1.JobExperience
+		if err := readMember(document, &member); err != nil {
+			return err
+		}
+
+		if belongs(profile, member.Spec.Collection.Name, member.Spec.Collection.Namespace) {
+			members.JobExperiences = append(members.JobExperiences, member)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	if err := readMembers(f.CertificationManifests, "Certification", func(document []byte) error {
+		var member resumesv1alpha1.Certification
+		if err := readMember(document, &member); err != nil {
+			return err
+		}
+
+		if belongs(profile, member.Spec.Collection.Name, member.Spec.Collection.Namespace) {
+			members.Certifications = append(members.Certifications, member)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	if err := readMembers(f.EducationManifests, "Education", func(document []byte) error {
+		var member resumesv1alpha1.Education
+		if err := readMember(document, &member); err != nil {
+			return err
+		}
+
+		if belongs(profile, member.Spec.Collection.Name, member.Spec.Collection.Namespace) {
+			members.Educations = append(members.Educations, member)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	if err := readMembers(f.ProjectManifests, "Project", func(document []byte) error {
+		var member resumesv1alpha1.Project
+		if err := readMember(document, &member); err != nil {
+			return err
+		}
+
+		if belongs(profile, member.Spec.Collection.Name, member.Spec.Collection.Namespace) {
+			members.Projects = append(members.Projects, member)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	members.Sort()
+
+	return profile, members, nil
+}
+
+// ReadVariant reads the ResumeVariant from a manifest file.  The first ResumeVariant in the
+// file is read.
+func ReadVariant(filename string) (*resumesv1alpha1.ResumeVariant, error) {
+	var variant *resumesv1alpha1.ResumeVariant
+
+	if err := readMembers(filename, "ResumeVariant", func(document []byte) error {
+		if variant != nil {
+			return nil
+		}
+
+		variant = &resumesv1alpha1.ResumeVariant{}
+
+		return readMember(document, variant)
+	}); err != nil {
+		return nil, err
+	}
+
+	if variant == nil {
+		return nil, fmt.Errorf("%w, no ResumeVariant in %s", ErrMissingManifest, filename)
+	}
+
+	return variant, nil
+}
+
+// readProfile reads the Profile collection manifest as a v1beta1 Profile.
+func readProfile(filename string) (*resumesv1beta1.Profile, error) {
+	profileFile, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open profile file %s, %w", filename, err)
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(profileFile, &typeMeta); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal yaml into profile, %w", err)
+	}
+
+	// generate a map of all versions to the functions which read a profile of that version
+	type collectionFunc func([]byte) (*resumesv1beta1.Profile, error)
+	collectionFuncMap := map[string]collectionFunc{
+		resumesv1alpha1.GroupVersion.String(): v1alpha1profile.CollectionForCLI,
+		resumesv1beta1.GroupVersion.String():  v1beta1profile.CollectionForCLI,
+	}
+
+	collection, ok := collectionFuncMap[typeMeta.APIVersion]
+	if !ok {
+		return nil, fmt.Errorf("%w %q for profile", ErrUnknownAPIVersion, typeMeta.APIVersion)
+	}
+
+	return collection(profileFile)
+}
+
+// readMember unmarshals a manifest into a member and validates its kind.
+func readMember(document []byte, member workload.Workload) error {
+	if err := yaml.Unmarshal(document, member); err != nil {
+		return fmt.Errorf("failed to unmarshal yaml into %T, %w", member, err)
+	}
+
+	if err := workload.Validate(member); err != nil {
+		return fmt.Errorf("error validating %T yaml, %w", member, err)
+	}
+
+	return nil
+}
+
+// readMembers calls read with each manifest of a kind which is found in path, which is a
+// manifest file or a directory of manifest files.  Manifests of other kinds are skipped, so
+// that the same directory may hold the manifests of every kind.
+func readMembers(path, kind string, read func([]byte) error) error {
+	if path == "" {
+		return nil
+	}
+
+	filenames := []string{path}
+
+	if info, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to open %s manifests %s, %w", kind, path, err)
+	} else if info.IsDir() {
+		filenames = []string{}
+
+		for _, pattern := range []string{"*.yaml", "*.yml"} {
+			matches, _ := filepath.Glob(filepath.Join(path, pattern))
+			filenames = append(filenames, matches...)
+		}
+
+		sort.Strings(filenames)
+	}
+
+	for _, filename := range filenames {
+		manifestFile, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("failed to open %s manifest %s, %w", kind, filename, err)
+		}
+
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifestFile)))
+
+		for {
+			document, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to read %s manifest %s, %w", kind, filename, err)
+			}
+
+			var typeMeta metav1.TypeMeta
+			if err := yaml.Unmarshal(document, &typeMeta); err != nil {
+				return fmt.Errorf("failed to unmarshal yaml in %s, %w", filename, err)
+			}
+
+			if typeMeta.Kind != kind {
+				continue
+			}
+
+			if err := read(document); err != nil {
+				return fmt.Errorf("%s, %w", filename, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// belongs returns whether a member which names its collection belongs to the profile.  A
+// member which does not name its collection belongs to any profile.
+func belongs(profile *resumesv1beta1.Profile, name, namespace string) bool {
+	if name != "" && name != profile.Name {
+		return false
+	}
+
+	return namespace == "" || profile.Namespace == "" || namespace == profile.Namespace
+}
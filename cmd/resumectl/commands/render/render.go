@@ -0,0 +1,147 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+	"github.com/jefedavis/resume-operator/cmd/resumectl/commands/manifests"
+	resumerender "github.com/jefedavis/resume-operator/internal/render"
+)
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+// formats are the functions which render a resume, by the name of their format.
+var formats = map[string]func(*resumerender.Resume) ([]byte, error){
+	"html": resumerender.HTML,
+	"pdf":  pdf,
+	"md":   resumerender.Markdown,
+	"txt":  resumerender.Text,
+	"ats":  resumerender.ATSText,
+	"docx": resumerender.DOCX,
+}
+
+// pdf renders the resume as a PDF, and warns of the content which the PDF leaves out on
+// standard error.
+func pdf(resume *resumerender.Resume) ([]byte, error) {
+	document, warnings, err := resumerender.PDFWithWarnings(resume)
+
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+
+	return document, err
+}
+
+type RenderSubCommand struct {
+	*cobra.Command
+
+	// flags
+	manifests.Files
+	Variant string
+	Format  string
+	Output  string
+}
+
+// NewRenderSubCommand creates a new command and adds it to its parent command.
+func NewRenderSubCommand(parentCommand *cobra.Command) *RenderSubCommand {
+	renderCmd := &RenderSubCommand{}
+
+	renderCmd.Setup()
+	parentCommand.AddCommand(renderCmd.Command)
+
+	return renderCmd
+}
+
+// Setup sets up this command to be used as a command.
+func (r *RenderSubCommand) Setup() {
+	r.Command = &cobra.Command{
+		Use:   "render",
+		Short: "render a resume locally from a profile collection and its component manifests",
+		Long: "render a resume locally from a profile collection and its component manifests, " +
+			"as the operator would, without a cluster",
+		RunE: r.render,
+	}
+
+	r.Files.AddFlags(r.Command)
+	r.Flags().StringVarP(&r.Variant, "variant", "", "", "filepath to a ResumeVariant manifest, to render the variant rather than the whole resume")
+	r.Flags().StringVarP(&r.Format, "format", "f", "html", "format of the resume, one of html, pdf, md, txt, or ats and docx for applicant tracking systems")
+	r.Flags().StringVarP(&r.Output, "output", "o", "", "filepath to write the resume to, standard out if unset")
+
+	if err := r.MarkFlagRequired("profile"); err != nil {
+		panic(err)
+	}
+}
+
+// render renders the resume and writes it to the output.
+func (r *RenderSubCommand) render(cmd *cobra.Command, args []string) error {
+	renderFunc, ok := formats[r.Format]
+	if !ok {
+		return fmt.Errorf("%w %q, must be one of html, pdf, md, txt, ats or docx", ErrUnknownFormat, r.Format)
+	}
+
+	resume, err := r.resume()
+	if err != nil {
+		return err
+	}
+
+	document, err := renderFunc(resume)
+	if err != nil {
+		return fmt.Errorf("unable to render resume, %w", err)
+	}
+
+	if r.Output == "" {
+		if _, err := os.Stdout.Write(document); err != nil {
+			return fmt.Errorf("failed to write output, %w", err)
+		}
+
+		return nil
+	}
+
+	if err := os.WriteFile(r.Output, document, 0o644); err != nil {
+		return fmt.Errorf("failed to write output file %s, %w", r.Output, err)
+	}
+
+	return nil
+}
+
+// resume reads the manifests into the data which the resume is rendered from, as selected by
+// the variant when one is given.
+func (r *RenderSubCommand) resume() (*resumerender.Resume, error) {
+	profile, members, err := r.Files.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Variant != "" {
+		variant, err := manifests.ReadVariant(r.Variant)
+		if err != nil {
+			return nil, err
+		}
+
+		if profile, members, err = v1beta1profile.Select(profile, members, variant); err != nil {
+			return nil, err
+		}
+	}
+
+	return v1beta1profile.Resume(profile, members), nil
+}
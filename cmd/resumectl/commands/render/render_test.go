@@ -0,0 +1,211 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jefedavis/resume-operator/cmd/resumectl/commands/manifests"
+)
+
+const testProfile = `apiVersion: resumes.jefedavis.dev/v1beta1
+kind: Profile
+metadata:
+  name: jane
+  namespace: resumes
+spec:
+  pageTitle: "Jane Doe - CV"
+  pageCount: 1
+  profile:
+    firstName: "Jane"
+    lastName: "Doe"
+`
+
+const testExperiences = `apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: JobExperience
+metadata:
+  name: acme
+  namespace: resumes
+  labels:
+    resumes.jefedavis.dev/track: "platform"
+spec:
+  collection:
+    name: jane
+    namespace: resumes
+  employer: "Acme"
+  startDate: "2019-03"
+  positions:
+    - title: "Platform Engineer"
+      startDate: "2019-03"
+      endDate: "present"
+---
+apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: JobExperience
+metadata:
+  name: initech
+  namespace: resumes
+spec:
+  collection:
+    name: jane
+  employer: "Initech"
+  startDate: "2015-01"
+---
+apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: JobExperience
+metadata:
+  name: globex
+  namespace: resumes
+spec:
+  employer: "Globex"
+  startDate: "2012-01"
+---
+apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: JobExperience
+metadata:
+  name: hooli
+  namespace: resumes
+spec:
+  collection:
+    name: john
+    namespace: resumes
+  employer: "Hooli"
+  startDate: "2018-01"
+---
+apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: JobExperience
+metadata:
+  name: umbrella
+  namespace: other
+spec:
+  collection:
+    name: jane
+    namespace: other
+  employer: "Umbrella"
+  startDate: "2017-01"
+`
+
+const testVariant = `apiVersion: resumes.jefedavis.dev/v1alpha1
+kind: ResumeVariant
+metadata:
+  name: platform
+  namespace: resumes
+spec:
+  jobExperienceSelector:
+    matchLabels:
+      resumes.jefedavis.dev/track: "platform"
+`
+
+var _ = Describe("RenderSubCommand", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "render")
+		Expect(err).NotTo(HaveOccurred())
+
+		for filename, manifest := range map[string]string{
+			"profile.yaml":     testProfile,
+			"experiences.yaml": testExperiences,
+			"variant.yaml":     testVariant,
+		} {
+			Expect(os.WriteFile(filepath.Join(dir, filename), []byte(manifest), 0o600)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	// run runs the render command with the manifests of the test and the given arguments.
+	run := func(args ...string) error {
+		parentCommand := &cobra.Command{Use: "resumectl"}
+		NewRenderSubCommand(parentCommand)
+
+		parentCommand.SetArgs(append([]string{
+			"render",
+			"--profile", filepath.Join(dir, "profile.yaml"),
+			"--experience", filepath.Join(dir, "experiences.yaml"),
+		}, args...))
+		parentCommand.SilenceUsage = true
+		parentCommand.SilenceErrors = true
+
+		return parentCommand.Execute()
+	}
+
+	// output renders the resume in a format and returns what is written.
+	output := func(format string, args ...string) string {
+		filename := filepath.Join(dir, "resume."+format)
+		Expect(run(append([]string{"--format", format, "--output", filename}, args...)...)).To(Succeed())
+
+		document, err := os.ReadFile(filename)
+		Expect(err).NotTo(HaveOccurred())
+
+		return string(document)
+	}
+
+	It("should render the resume in the format which is asked for", func() {
+		Expect(output("html")).To(And(HavePrefix("<!DOCTYPE html>"), ContainSubstring("Acme")))
+		Expect(output("pdf")).To(HavePrefix("%PDF-1.4\n"))
+		Expect(output("md")).To(And(HavePrefix("# Jane Doe"), ContainSubstring("Acme")))
+		Expect(output("txt")).To(ContainSubstring("Acme"))
+		Expect(output("ats")).To(ContainSubstring("Acme"))
+		Expect(output("docx")).To(HavePrefix("PK"))
+	})
+
+	It("should reject an unknown format", func() {
+		Expect(run("--format", "rtf")).To(MatchError(ErrUnknownFormat))
+	})
+
+	It("should fail to render a variant which cannot be found", func() {
+		Expect(run("--variant", filepath.Join(dir, "profile.yaml"))).To(MatchError(manifests.ErrMissingManifest))
+		Expect(run("--variant", filepath.Join(dir, "missing.yaml"))).To(HaveOccurred())
+	})
+
+	It("should only render the members which belong to the profile", func() {
+		r := &RenderSubCommand{Files: manifests.Files{
+			ProfileManifest:     filepath.Join(dir, "profile.yaml"),
+			ExperienceManifests: filepath.Join(dir, "experiences.yaml"),
+		}}
+
+		resume, err := r.resume()
+		Expect(err).NotTo(HaveOccurred())
+
+		employers := []string{}
+		for _, experience := range resume.JobExperiences {
+			employers = append(employers, experience.Spec.Employer)
+		}
+
+		// a member which names no collection, or no namespace of it, belongs to any profile
+		Expect(employers).To(ConsistOf("Acme", "Initech", "Globex"))
+
+		text := output("txt")
+		Expect(text).NotTo(ContainSubstring("Hooli"))
+		Expect(text).NotTo(ContainSubstring("Umbrella"))
+	})
+
+	It("should render the members which the variant selects", func() {
+		text := output("txt", "--variant", filepath.Join(dir, "variant.yaml"))
+		Expect(text).To(ContainSubstring("Acme"))
+		Expect(text).NotTo(ContainSubstring("Initech"))
+	})
+})
@@ -20,8 +20,12 @@ import (
 	"github.com/spf13/cobra"
 
 	// common imports for subcommands
+	cmdexport "github.com/jefedavis/resume-operator/cmd/resumectl/commands/exporter"
 	cmdgenerate "github.com/jefedavis/resume-operator/cmd/resumectl/commands/generate"
+	cmdimport "github.com/jefedavis/resume-operator/cmd/resumectl/commands/importer"
 	cmdinit "github.com/jefedavis/resume-operator/cmd/resumectl/commands/init"
+	cmdrender "github.com/jefedavis/resume-operator/cmd/resumectl/commands/render"
+	cmdtailor "github.com/jefedavis/resume-operator/cmd/resumectl/commands/tailor"
 	cmdversion "github.com/jefedavis/resume-operator/cmd/resumectl/commands/version"
 
 	// specific imports for workloads
@@ -65,6 +69,9 @@ func (c *ResumectlCommand) newInitSubCommand() {
 	initresumes.NewProfileSubCommand(parentCommand)
 	initresumes.NewJobExperienceSubCommand(parentCommand)
 	initresumes.NewCertificationSubCommand(parentCommand)
+	initresumes.NewEducationSubCommand(parentCommand)
+	initresumes.NewProjectSubCommand(parentCommand)
+	initresumes.NewResumeVariantSubCommand(parentCommand)
 	//+kubebuilder:scaffold:operator-builder:subcommands:init
 }
 
@@ -76,6 +83,9 @@ func (c *ResumectlCommand) newGenerateSubCommand() {
 	generateresumes.NewProfileSubCommand(parentCommand)
 	generateresumes.NewJobExperienceSubCommand(parentCommand)
 	generateresumes.NewCertificationSubCommand(parentCommand)
+	generateresumes.NewEducationSubCommand(parentCommand)
+	generateresumes.NewProjectSubCommand(parentCommand)
+	generateresumes.NewResumeVariantSubCommand(parentCommand)
 	//+kubebuilder:scaffold:operator-builder:subcommands:generate
 }
 
@@ -87,12 +97,42 @@ func (c *ResumectlCommand) newVersionSubCommand() {
 	versionresumes.NewProfileSubCommand(parentCommand)
 	versionresumes.NewJobExperienceSubCommand(parentCommand)
 	versionresumes.NewCertificationSubCommand(parentCommand)
+	versionresumes.NewEducationSubCommand(parentCommand)
+	versionresumes.NewProjectSubCommand(parentCommand)
+	versionresumes.NewResumeVariantSubCommand(parentCommand)
 	//+kubebuilder:scaffold:operator-builder:subcommands:version
 }
 
+func (c *ResumectlCommand) newRenderSubCommand() {
+	cmdrender.NewRenderSubCommand(c.Command)
+}
+
+func (c *ResumectlCommand) newTailorSubCommand() {
+	cmdtailor.NewTailorSubCommand(c.Command)
+}
+
+func (c *ResumectlCommand) newImportSubCommand() {
+	parentCommand := cmdimport.NewBaseImportSubCommand(c.Command).Command
+
+	// add the import subcommands
+	cmdimport.NewJSONResumeSubCommand(parentCommand)
+	cmdimport.NewLinkedInSubCommand(parentCommand)
+}
+
+func (c *ResumectlCommand) newExportSubCommand() {
+	parentCommand := cmdexport.NewBaseExportSubCommand(c.Command).Command
+
+	// add the export subcommands
+	cmdexport.NewJSONResumeSubCommand(parentCommand)
+}
+
 // addSubCommands adds any additional subCommands to the root command.
 func (c *ResumectlCommand) addSubCommands() {
 	c.newInitSubCommand()
 	c.newGenerateSubCommand()
 	c.newVersionSubCommand()
+	c.newRenderSubCommand()
+	c.newTailorSubCommand()
+	c.newImportSubCommand()
+	c.newExportSubCommand()
 }
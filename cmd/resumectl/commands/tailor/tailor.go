@@ -0,0 +1,262 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tailor
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	v1beta1profile "github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+	"github.com/jefedavis/resume-operator/cmd/resumectl/commands/manifests"
+	"github.com/jefedavis/resume-operator/internal/render"
+	"github.com/jefedavis/resume-operator/internal/tailor"
+)
+
+type TailorSubCommand struct {
+	*cobra.Command
+
+	// flags
+	manifests.Files
+	JobDescription string
+	Output         string
+	Variant        string
+	VariantName    string
+	Top            int
+
+	HighlightsPerPage int
+}
+
+// NewTailorSubCommand creates a new command and adds it to its parent command.
+func NewTailorSubCommand(parentCommand *cobra.Command) *TailorSubCommand {
+	tailorCmd := &TailorSubCommand{}
+
+	tailorCmd.Setup()
+	parentCommand.AddCommand(tailorCmd.Command)
+
+	return tailorCmd
+}
+
+// Setup sets up this command to be used as a command.
+func (t *TailorSubCommand) Setup() {
+	t.Command = &cobra.Command{
+		Use:   "tailor",
+		Short: "match a resume against a job description and report how well it is covered",
+		Long: "match the highlights, skills and certifications of a profile collection and its component " +
+			"manifests against the keywords of a job description, report the keywords which the resume " +
+			"does not cover, and optionally write a ResumeVariant which keeps the best matching highlights " +
+			"within the page count of the profile",
+		RunE: t.tailor,
+	}
+
+	t.Files.AddFlags(t.Command)
+	t.Flags().StringVarP(&t.JobDescription, "job", "j", "", "filepath to the job description, as plain text")
+	t.Flags().StringVarP(&t.Output, "output", "o", "", "filepath to write the report to, standard out if unset")
+	t.Flags().StringVarP(&t.Variant, "variant", "", "", "filepath to write a ResumeVariant manifest to, which keeps the best matching highlights")
+	t.Flags().StringVarP(&t.VariantName, "variant-name", "", "tailored", "name of the ResumeVariant, which is also its path within the resume site")
+	t.Flags().IntVarP(&t.Top, "top", "", 20, "number of keywords and coverage gaps to report, all of them if less than one")
+	t.Flags().IntVarP(&t.HighlightsPerPage, "highlights-per-page", "", 0,
+		"estimated number of highlights which a page holds, instead of laying out the variant as a PDF to fit its highlights within the page count")
+
+	for _, flag := range []string{"profile", "job"} {
+		if err := t.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// tailor matches the resume against the job description and writes the report, along with
+// the ResumeVariant when requested.
+func (t *TailorSubCommand) tailor(cmd *cobra.Command, args []string) error {
+	jobDescription, err := os.ReadFile(t.JobDescription)
+	if err != nil {
+		return fmt.Errorf("failed to open job description %s, %w", t.JobDescription, err)
+	}
+
+	profile, members, err := t.Files.Read()
+	if err != nil {
+		return err
+	}
+
+	report := tailor.Match(v1beta1profile.Resume(profile, members), string(jobDescription))
+
+	budget, sizing, err := t.budget(profile, members, report)
+	if err != nil {
+		return err
+	}
+
+	if t.Variant != "" {
+		variant := t.variant(profile, report.Excluded(budget))
+
+		manifest, err := yaml.Marshal(variant)
+		if err != nil {
+			return fmt.Errorf("failed to marshal ResumeVariant %s, %w", variant.Name, err)
+		}
+
+		if err := os.WriteFile(t.Variant, manifest, 0o644); err != nil {
+			return fmt.Errorf("failed to write ResumeVariant file %s, %w", t.Variant, err)
+		}
+	}
+
+	var document bytes.Buffer
+
+	writeReport(&document, report, budget, sizing, t.Top)
+
+	if t.Output == "" {
+		if _, err := os.Stdout.Write(document.Bytes()); err != nil {
+			return fmt.Errorf("failed to write output, %w", err)
+		}
+
+		return nil
+	}
+
+	if err := os.WriteFile(t.Output, document.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write output file %s, %w", t.Output, err)
+	}
+
+	return nil
+}
+
+// variant returns the ResumeVariant of the profile which leaves out the excluded highlights.
+func (t *TailorSubCommand) variant(profile *resumesv1beta1.Profile, excluded []string) *resumesv1alpha1.ResumeVariant {
+	return &resumesv1alpha1.ResumeVariant{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: resumesv1alpha1.GroupVersion.String(),
+			Kind:       "ResumeVariant",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      t.VariantName,
+			Namespace: profile.Namespace,
+		},
+		Spec: resumesv1alpha1.ResumeVariantSpec{
+			Collection: resumesv1alpha1.ResumeVariantCollectionSpec{
+				Name:      profile.Name,
+				Namespace: profile.Namespace,
+			},
+			ExcludeHighlights: excluded,
+		},
+	}
+}
+
+// budget returns the number of highlights which the variant keeps, along with how it was
+// sized.  Each candidate variant is laid out as a PDF, keeping fewer highlights until it fits
+// within the page count of the profile, unless the number of highlights per page is given.
+func (t *TailorSubCommand) budget(
+	profile *resumesv1beta1.Profile,
+	members *v1beta1profile.Members,
+	report *tailor.Report,
+) (int, string, error) {
+	pageCount := profile.Spec.PageCount
+	if pageCount < 1 {
+		pageCount = resumesv1beta1.DefaultPageCount
+	}
+
+	if t.HighlightsPerPage > 0 {
+		return pageCount * t.HighlightsPerPage, fmt.Sprintf("an estimated %d per page", t.HighlightsPerPage), nil
+	}
+
+	var selectErr error
+
+	budget := report.Fit(func(budget int) bool {
+		selectedProfile, selectedMembers, err := v1beta1profile.Select(profile, members, t.variant(profile, report.Excluded(budget)))
+		if err != nil {
+			selectErr = err
+
+			return true
+		}
+
+		return render.PDFPages(v1beta1profile.Resume(selectedProfile, selectedMembers)) <= pageCount
+	})
+
+	if selectErr != nil {
+		return 0, "", fmt.Errorf("failed to select ResumeVariant %s, %w", t.VariantName, selectErr)
+	}
+
+	return budget, fmt.Sprintf("as many as the PDF fits within %d page(s) at full scale", pageCount), nil
+}
+
+// writeReport writes a report as plain text: the keywords of the job description, the scored
+// items of the resume which highlights are kept within the budget, and the coverage gaps.
+func writeReport(output io.Writer, report *tailor.Report, budget int, sizing string, top int) {
+	fmt.Fprintf(output, "KEYWORDS\n%s\n", keywords(report.Keywords, top))
+
+	kept := map[string]bool{}
+	for _, highlight := range report.Keep(budget) {
+		kept[strings.ToLower(highlight.Text)] = true
+	}
+
+	sections := []struct {
+		title string
+		items []tailor.Item
+	}{
+		{"HIGHLIGHTS", report.Highlights},
+		{"SKILLS", report.Skills},
+		{"CERTIFICATIONS", report.Certifications},
+	}
+
+	for _, section := range sections {
+		fmt.Fprintf(output, "\n%s\n", section.title)
+
+		writer := tabwriter.NewWriter(output, 0, 4, 2, ' ', 0)
+
+		for _, item := range section.items {
+			mark := ""
+			if item.Kind == tailor.KindHighlight && !kept[strings.ToLower(item.Text)] {
+				mark = "-"
+			}
+
+			fmt.Fprintf(writer, "%s\t%d\t%s\t%s\t%s\n", mark, item.Score, item.Source, item.Text, strings.Join(item.Matches, ", "))
+		}
+
+		writer.Flush()
+	}
+
+	if len(kept) < len(report.Highlights) {
+		fmt.Fprintf(output, "\nhighlights marked - are left out of a variant, to keep %d highlights, %s\n",
+			budget, sizing)
+	}
+
+	fmt.Fprintf(output, "\nCOVERAGE GAPS\n%s\n", keywords(report.Gaps, top))
+}
+
+// keywords returns the display form of at most limit keywords, or all of them if limit is less
+// than one.
+func keywords(keywords []tailor.Keyword, limit int) string {
+	if limit > 0 && len(keywords) > limit {
+		keywords = keywords[:limit]
+	}
+
+	if len(keywords) == 0 {
+		return "none"
+	}
+
+	display := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		display = append(display, fmt.Sprintf("%s (%d)", keyword.Term, keyword.Count))
+	}
+
+	return strings.Join(display, ", ")
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -41,6 +42,8 @@ import (
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
 	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/certification"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 	"github.com/jefedavis/resume-operator/internal/dependencies"
 	"github.com/jefedavis/resume-operator/internal/mutate"
 )
@@ -91,8 +94,14 @@ func NewCertificationReconciler(mgr ctrl.Manager) *CertificationReconciler {
 func (r *CertificationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	req, err := r.NewRequest(ctx, request)
 	if err != nil {
+		// the collection may be deleted before its members, which are then deleted without it
+		if req != nil && !req.Workload.GetDeletionTimestamp().IsZero() {
+			return r.Phases.HandleExecution(r, req)
+		}
+
+		// the member is reconciled again once its collection is created
 		if errors.Is(err, workload.ErrCollectionNotFound) {
-			return ctrl.Result{Requeue: true}, nil
+			return ctrl.Result{}, updateOrphanStatus(r, req)
 		}
 
 		if !apierrs.IsNotFound(err) {
@@ -145,49 +154,59 @@ func (r *CertificationReconciler) NewRequest(ctx context.Context, request ctrl.R
 func (r *CertificationReconciler) SetCollection(component *resumesv1alpha1.Certification, req *workload.Request) error {
 	collection, err := r.GetCollection(component, req)
 	if err != nil || collection == nil {
+		if errors.Is(err, workload.ErrCollectionNotFound) {
+			if err := r.setCollectionNotFoundCondition(component, req); err != nil {
+				return fmt.Errorf("unable to set collection, %w", err)
+			}
+		}
+
 		return fmt.Errorf("unable to set collection, %w", err)
 	}
 
-	req.Collection = collection
-
-	return r.EnqueueRequestOnCollectionChange(req)
-}
-
-// GetCollection gets a collection for a component given a list.
-func (r *CertificationReconciler) GetCollection(
-	component *resumesv1alpha1.Certification,
-	req *workload.Request,
-) (*resumesv1alpha1.Profile, error) {
-	var collectionList resumesv1alpha1.ProfileList
+	// a collection which is being deleted renders no more output for its members, as the
+	// output is deleted along with it
+	if !collection.GetDeletionTimestamp().IsZero() {
+		component.SetCollectionCondition(collectionCondition(component, collection.Name, collection.Namespace, false))
 
-	if err := r.List(req.Context, &collectionList); err != nil {
-		return nil, fmt.Errorf("unable to list collection Profile, %w", err)
+		return fmt.Errorf("unable to set collection, %w", workload.ErrCollectionNotFound)
 	}
 
-	// determine if we have requested a specific collection
-	name, namespace := component.Spec.Collection.Name, component.Spec.Collection.Namespace
+	component.SetCollectionCondition(collectionCondition(component, collection.Name, collection.Namespace, true))
 
-	var collectionRef resumesv1alpha1.CertificationCollectionSpec
+	req.Collection = collection
 
-	hasSpecificCollection := component.Spec.Collection != collectionRef && component.Spec.Collection.Name != ""
+	return r.EnqueueRequestOnCollectionChange(req)
+}
 
-	// if a specific collection has not been requested, we ensure only one exists
-	if !hasSpecificCollection {
-		if len(collectionList.Items) != 1 {
-			return nil, fmt.Errorf("expected only 1 Profile collection, found %v", len(collectionList.Items))
-		}
+// setCollectionNotFoundCondition sets the condition of a component whose collection is not
+// found.  A component which does not reference a collection may still be adopted by the
+// memberSelector of a collection, which renders it without it belonging to the collection.
+func (r *CertificationReconciler) setCollectionNotFoundCondition(component *resumesv1alpha1.Certification, req *workload.Request) error {
+	if component.Spec.Collection.Name != "" {
+		component.SetCollectionCondition(
+			collectionCondition(component, component.Spec.Collection.Name, component.Spec.Collection.Namespace, false),
+		)
 
-		return &collectionList.Items[0], nil
+		return nil
 	}
 
-	// find the collection that was requested and return it
-	for _, collection := range collectionList.Items {
-		if collection.Name == name && collection.Namespace == namespace {
-			return &collection, nil
-		}
+	adopters, err := resume.SelectingCollections(req.Context, r, component)
+	if err != nil {
+		return err
 	}
 
-	return nil, workload.ErrCollectionNotFound
+	component.SetCollectionCondition(adoptionCondition(component, adopters))
+
+	return nil
+}
+
+// GetCollection gets the collection of a component, which is resolved as it is for the other
+// members of the collection.
+func (r *CertificationReconciler) GetCollection(
+	component *resumesv1alpha1.Certification,
+	req *workload.Request,
+) (*resumesv1alpha1.Profile, error) {
+	return getCollection(req.Context, r, component.Spec.Collection.Name, component.Spec.Collection.Namespace)
 }
 
 // EnqueueRequestOnCollectionChange enqueues a reconcile request when an associated collection object changes.
@@ -332,6 +351,13 @@ func (r *CertificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	baseController, err := ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(predicates.WorkloadPredicates()).
 		For(&resumesv1alpha1.Certification{}).
+		Watches(
+			&source.Kind{Type: &resumesv1beta1.Profile{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				return enqueueRequestsForMembers(r, &resumesv1alpha1.CertificationList{}, object)
+			}),
+			builder.WithPredicates(collectionMembershipChanged),
+		).
 		Build(r)
 	if err != nil {
 		return fmt.Errorf("unable to setup controller, %w", err)
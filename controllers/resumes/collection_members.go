@@ -0,0 +1,197 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resumes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+)
+
+// collectionMembershipChanged filters the events of a collection down to those which change
+// its members: its creation, which the members that reference it before it exists are
+// reconciled on, and changes to its memberSelector, which the members that it adopts are
+// reconciled on.
+var collectionMembershipChanged = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return true
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldCollection, ok := e.ObjectOld.(*resumesv1beta1.Profile)
+		if !ok {
+			return false
+		}
+
+		newCollection, ok := e.ObjectNew.(*resumesv1beta1.Profile)
+		if !ok {
+			return false
+		}
+
+		return !equality.Semantic.DeepEqual(oldCollection.Spec.MemberSelector, newCollection.Spec.MemberSelector)
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// memberChanged filters the events of a member which a collection may adopt down to changes
+// of its generation, as for any workload, or of its labels, which the memberSelector of a
+// collection selects it by.
+var memberChanged = predicate.Or(predicates.WorkloadPredicates(), predicate.LabelChangedPredicate{})
+
+// getCollection gets the collection with the given name and namespace of a component, as it
+// is resolved with resume.GetCollection, converted to the v1alpha1 Profile which the resources
+// of the component are created from.
+func getCollection(ctx context.Context, reader client.Reader, name, namespace string) (*resumesv1alpha1.Profile, error) {
+	collection, err := resume.GetCollection(ctx, reader, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := &resumesv1alpha1.Profile{}
+	if err := converted.ConvertFrom(collection); err != nil {
+		return nil, fmt.Errorf("unable to convert collection Profile %s, %w", collection.Name, err)
+	}
+
+	return converted, nil
+}
+
+// collectionCondition returns the condition of a member of a collection with the given name
+// and namespace, which is whether the collection is found.
+func collectionCondition(member workload.Workload, name, namespace string, found bool) metav1.Condition {
+	if !found {
+		return metav1.Condition{
+			Type:               resumesv1alpha1.ConditionCollectionFound,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: member.GetGeneration(),
+			Reason:             resumesv1alpha1.ReasonCollectionNotFound,
+			Message: fmt.Sprintf(
+				"Profile %s/%s does not exist or is being deleted, the %s is rendered once it is created",
+				namespace, name, member.GetWorkloadGVK().Kind,
+			),
+		}
+	}
+
+	return metav1.Condition{
+		Type:               resumesv1alpha1.ConditionCollectionFound,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: member.GetGeneration(),
+		Reason:             resumesv1alpha1.ReasonCollectionFound,
+		Message:            fmt.Sprintf("rendered into Profile %s/%s", namespace, name),
+	}
+}
+
+// updateOrphanStatus updates the status of a member whose collection is not found, which
+// holds the condition that says so, rather than requeueing the member until the collection
+// exists.  The member is reconciled again once the collection is created.
+func updateOrphanStatus(r workload.Reconciler, req *workload.Request) error {
+	if err := r.Status().Update(req.Context, req.Workload); err != nil {
+		return fmt.Errorf("unable to update status of %s %s, %w", req.Workload.GetWorkloadGVK().Kind, req.Workload.GetName(), err)
+	}
+
+	return nil
+}
+
+// enqueueRequestsForMembers returns the reconcile requests for the members of a kind, listed
+// into the given list, which reference the given collection through the collection index,
+// along with those which do not reference a collection, as they may belong to the only
+// collection in the cluster or be adopted by the given collection.
+func enqueueRequestsForMembers(r workload.Reconciler, list client.ObjectList, collection client.Object) []reconcile.Request {
+	requests := []reconcile.Request{}
+
+	for _, value := range []string{
+		resume.CollectionIndexValue(collection.GetName(), collection.GetNamespace()),
+		resume.CollectionIndexValue("", ""),
+	} {
+		if err := r.List(context.Background(), list, client.MatchingFields{resume.CollectionIndex: value}); err != nil {
+			r.GetLogger().Error(err, "unable to list members of collection", "name", collection.GetName(), "namespace", collection.GetNamespace())
+
+			return nil
+		}
+
+		if err := meta.EachListItem(list, func(object runtime.Object) error {
+			member, ok := object.(client.Object)
+			if !ok {
+				return nil
+			}
+
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      member.GetName(),
+					Namespace: member.GetNamespace(),
+				},
+			})
+
+			return nil
+		}); err != nil {
+			r.GetLogger().Error(err, "unable to list members of collection", "name", collection.GetName(), "namespace", collection.GetNamespace())
+
+			return nil
+		}
+	}
+
+	return requests
+}
+
+// adoptionCondition returns the condition of a member which does not reference a collection,
+// which is whether it is adopted by the memberSelector of any of the given collections.
+func adoptionCondition(member workload.Workload, adopters []resumesv1beta1.Profile) metav1.Condition {
+	if len(adopters) == 0 {
+		return metav1.Condition{
+			Type:               resumesv1alpha1.ConditionCollectionFound,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: member.GetGeneration(),
+			Reason:             resumesv1alpha1.ReasonCollectionNotFound,
+			Message: fmt.Sprintf(
+				"no collection is referenced and no Profile memberSelector selects the %s",
+				member.GetWorkloadGVK().Kind,
+			),
+		}
+	}
+
+	names := []string{}
+	for i := range adopters {
+		names = append(names, adopters[i].Namespace+"/"+adopters[i].Name)
+	}
+
+	return metav1.Condition{
+		Type:               resumesv1alpha1.ConditionCollectionFound,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: member.GetGeneration(),
+		Reason:             resumesv1alpha1.ReasonCollectionAdopted,
+		Message:            fmt.Sprintf("adopted by the memberSelector of Profile %s", strings.Join(names, ", ")),
+	}
+}
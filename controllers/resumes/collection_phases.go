@@ -0,0 +1,192 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resumes
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+)
+
+// RemoveMemberPhase removes the data of a member which is being deleted from the ConfigMaps
+// of its collection which hold the data of all members, such as the page and PDF of the
+// resume, so that the resume no longer shows the member once it is gone.  The ConfigMap of
+// the member itself is garbage collected through its owner reference.
+func RemoveMemberPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	// the collection may have been deleted before its members
+	if req.Collection == nil {
+		return true, nil
+	}
+
+	collection := &resumesv1beta1.Profile{}
+	if err := r.Get(req.Context, client.ObjectKeyFromObject(req.Collection), collection); err != nil {
+		if apierrs.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, fmt.Errorf("unable to get collection %s, %w", req.Collection.GetName(), err)
+	}
+
+	// the members which are being deleted, including this one, are left out
+	members, err := resume.ListMembers(req.Context, r, collection)
+	if err != nil {
+		return false, err
+	}
+
+	configMaps, err := resume.AggregateConfigMaps(collection, members)
+	if err != nil {
+		return false, err
+	}
+
+	for _, configMap := range configMaps {
+		if err := updateConfigMapData(r, req, configMap); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// updateConfigMapData sets the data of a ConfigMap in the cluster to that of the given
+// ConfigMap, leaving the rest of it, such as its owner, as it is.  A ConfigMap which does
+// not exist is left to be created by the controller of the collection.
+func updateConfigMapData(r workload.Reconciler, req *workload.Request, desired client.Object) error {
+	desiredConfigMap, ok := desired.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for ConfigMap %s", desired, desired.GetName())
+	}
+
+	configMap := &unstructured.Unstructured{}
+	configMap.SetGroupVersionKind(desiredConfigMap.GroupVersionKind())
+
+	if err := r.Get(req.Context, client.ObjectKeyFromObject(desired), configMap); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("unable to get ConfigMap %s, %w", desired.GetName(), err)
+	}
+
+	changed := false
+
+	for _, field := range []string{"data", "binaryData"} {
+		desiredData, ok := desiredConfigMap.Object[field]
+		if !ok {
+			desiredData = map[string]interface{}{}
+		}
+
+		existingData, ok := configMap.Object[field]
+		if !ok {
+			existingData = map[string]interface{}{}
+		}
+
+		if !reflect.DeepEqual(desiredData, existingData) {
+			configMap.Object[field] = desiredData
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := r.Update(req.Context, configMap); err != nil {
+		return fmt.Errorf("unable to update ConfigMap %s, %w", desired.GetName(), err)
+	}
+
+	return nil
+}
+
+// DeleteMemberOutputPhase deletes the output which is rendered for the members of a Profile
+// which is being deleted, unless its member deletion policy is Orphan.  The members are kept,
+// and render their output again once they are added to another collection.
+func DeleteMemberOutputPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	collection, err := resume.ConvertWorkload(req.Workload)
+	if err != nil {
+		return false, err
+	}
+
+	if reconciler, ok := r.(*ProfileReconciler); ok {
+		reconciler.Renders.Forget(collection)
+	}
+
+	if collection.Spec.MemberDeletionPolicy == resumesv1beta1.MemberDeletionPolicyOrphan {
+		return true, nil
+	}
+
+	members, err := resume.ListMembers(req.Context, r, collection)
+	if err != nil {
+		return false, err
+	}
+
+	resources, err := resume.MemberResources(collection, members)
+	if err != nil {
+		return false, err
+	}
+
+	for _, resource := range resources {
+		if err := r.Delete(req.Context, resource); err != nil && !apierrs.IsNotFound(err) {
+			return false, fmt.Errorf("unable to delete %s %s, %w", resource.GetObjectKind().GroupVersionKind().Kind, resource.GetName(), err)
+		}
+	}
+
+	return true, nil
+}
+
+// DeleteLegacyResourcesPhase deletes the child resources of a Profile which earlier versions of
+// the operator created and which are no longer generated, before the resources which replace
+// them are created.
+func DeleteLegacyResourcesPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	collection, err := resume.ConvertWorkload(req.Workload)
+	if err != nil {
+		return false, err
+	}
+
+	if err := resume.DeleteLegacyResources(req.Context, r, collection); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RecordRenderPhase records the last render of a Profile on its status, once its resources
+// have been created or updated, from the render which they were created from.  The status is
+// updated as the phase exits.
+func RecordRenderPhase(r workload.Reconciler, req *workload.Request) (bool, error) {
+	reconciler, ok := r.(*ProfileReconciler)
+	if !ok {
+		return false, fmt.Errorf("unexpected reconciler %T for recording the render of a Profile", r)
+	}
+
+	collection, err := resume.ConvertWorkload(req.Workload)
+	if err != nil {
+		return false, err
+	}
+
+	if render := reconciler.Renders.Last(collection); render != nil {
+		resume.SetRenderStatus(collection, render)
+	}
+
+	return true, nil
+}
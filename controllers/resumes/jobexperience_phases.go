@@ -81,6 +81,12 @@ func (r *JobExperienceReconciler) InitializePhases() {
 	)
 
 	// Delete Phases
+	r.Phases.Register(
+		"Remove-Member",
+		RemoveMemberPhase,
+		phases.DeleteEvent,
+	)
+
 	r.Phases.Register(
 		"DeletionComplete",
 		phases.DeletionCompletePhase,
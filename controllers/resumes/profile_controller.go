@@ -25,13 +25,19 @@ import (
 	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
-	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/resume"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 	"github.com/jefedavis/resume-operator/internal/dependencies"
 	"github.com/jefedavis/resume-operator/internal/mutate"
 )
@@ -46,6 +52,7 @@ type ProfileReconciler struct {
 	FieldManager string
 	Watches      []client.Object
 	Phases       *phases.Registry
+	Renders      *resume.Renders
 }
 
 func NewProfileReconciler(mgr ctrl.Manager) *ProfileReconciler {
@@ -57,6 +64,7 @@ func NewProfileReconciler(mgr ctrl.Manager) *ProfileReconciler {
 		Log:          ctrl.Log.WithName("controllers").WithName("resumes").WithName("Profile"),
 		Watches:      []client.Object{},
 		Phases:       &phases.Registry{},
+		Renders:      &resume.Renders{},
 	}
 }
 
@@ -64,8 +72,15 @@ func NewProfileReconciler(mgr ctrl.Manager) *ProfileReconciler {
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=profiles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=profiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=jobexperiences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=certifications,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=educations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=projects,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=resumevariants,verbs=get;list;watch
 
 // Until Webhooks are implemented we need to list and watch namespaces to ensure
 // they are available before deploying resources,
@@ -73,7 +88,7 @@ func NewProfileReconciler(mgr ctrl.Manager) *ProfileReconciler {
 //   - https://github.com/vmware-tanzu-labs/operator-builder/issues/141
 //   - https://github.com/vmware-tanzu-labs/operator-builder/issues/162
 
-// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -100,7 +115,7 @@ func (r *ProfileReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 }
 
 func (r *ProfileReconciler) NewRequest(ctx context.Context, request ctrl.Request) (*workload.Request, error) {
-	component := &resumesv1alpha1.Profile{}
+	component := &resumesv1beta1.Profile{}
 
 	log := r.Log.WithValues(
 		"kind", component.GetWorkloadGVK().Kind,
@@ -138,14 +153,19 @@ func (r *ProfileReconciler) GetResources(req *workload.Request) ([]client.Object
 		return nil, err
 	}
 
-	// create resources in memory
-	resources, err := resume.Generate(*component)
+	members, err := r.GetMembers(req, component)
+	if err != nil {
+		return nil, err
+	}
+
+	// create resources in memory, reusing the last render when nothing has changed
+	render, err := r.Renders.Generate(component, members)
 	if err != nil {
 		return nil, err
 	}
 
 	// run through the mutation functions to mutate the resources
-	for _, resource := range resources {
+	for _, resource := range render.Resources {
 		mutatedResources, skip, err := r.Mutate(req, resource)
 		if err != nil {
 			return []client.Object{}, err
@@ -161,6 +181,87 @@ func (r *ProfileReconciler) GetResources(req *workload.Request) ([]client.Object
 	return resourceObjects, nil
 }
 
+// GetMembers returns the components which belong to the collection.  The members which
+// reference the collection are read through the index of the collection, and only those within
+// the namespace of the collection are returned, as their rendered data is projected into the
+// resume site from within that namespace.  The JobExperiences and Certifications which are
+// adopted by the memberSelector of the collection may belong to any namespace which it selects.
+func (r *ProfileReconciler) GetMembers(
+	req *workload.Request,
+	component *resumesv1beta1.Profile,
+) (*resume.Members, error) {
+	return resume.ListIndexedMembers(req.Context, r, component)
+}
+
+// EnqueueRequestsForMember returns the reconcile requests for the collection which a
+// component belongs to, so that the collection is reconciled when its members change.
+func (r *ProfileReconciler) EnqueueRequestsForMember(name, namespace string) []reconcile.Request {
+	// a specific collection has been requested
+	if name != "" {
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Name:      name,
+					Namespace: namespace,
+				},
+			},
+		}
+	}
+
+	// a specific collection has not been requested, so the component belongs to the
+	// only collection in the cluster, if one exists
+	var collectionList resumesv1beta1.ProfileList
+
+	if err := r.List(context.Background(), &collectionList); err != nil {
+		r.Log.Error(err, "unable to list collection Profile")
+
+		return nil
+	}
+
+	if len(collectionList.Items) != 1 {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      collectionList.Items[0].Name,
+				Namespace: collectionList.Items[0].Namespace,
+			},
+		},
+	}
+}
+
+// EnqueueRequestsForAdopters returns the reconcile requests for the collections whose
+// memberSelector selects the labels of a component, so that the collections which adopt a
+// component are reconciled when it changes.
+func (r *ProfileReconciler) EnqueueRequestsForAdopters(member client.Object) []reconcile.Request {
+	var collectionList resumesv1beta1.ProfileList
+
+	if err := r.List(context.Background(), &collectionList); err != nil {
+		r.Log.Error(err, "unable to list collection Profile")
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range collectionList.Items {
+		if !resume.SelectsLabels(&collectionList.Items[i], member) {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      collectionList.Items[i].Name,
+				Namespace: collectionList.Items[i].Namespace,
+			},
+		})
+	}
+
+	return requests
+}
+
 // GetEventRecorder returns the event recorder for writing kubernetes events.
 func (r *ProfileReconciler) GetEventRecorder() record.EventRecorder {
 	return r.Events
@@ -212,9 +313,79 @@ func (r *ProfileReconciler) Mutate(
 func (r *ProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.InitializePhases()
 
+	// index the members by their collection, which the members are listed by
+	if err := resume.IndexMembers(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return fmt.Errorf("unable to setup controller, %w", err)
+	}
+
 	baseController, err := ctrl.NewControllerManagedBy(mgr).
-		WithEventFilter(predicates.WorkloadPredicates()).
-		For(&resumesv1alpha1.Profile{}).
+		For(&resumesv1beta1.Profile{}, builder.WithPredicates(predicates.WorkloadPredicates())).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.JobExperience{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.JobExperience)
+				if !ok {
+					return nil
+				}
+
+				return append(
+					r.EnqueueRequestsForMember(member.Spec.Collection.Name, member.Spec.Collection.Namespace),
+					r.EnqueueRequestsForAdopters(member)...,
+				)
+			}),
+			builder.WithPredicates(memberChanged),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.Certification{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.Certification)
+				if !ok {
+					return nil
+				}
+
+				return append(
+					r.EnqueueRequestsForMember(member.Spec.Collection.Name, member.Spec.Collection.Namespace),
+					r.EnqueueRequestsForAdopters(member)...,
+				)
+			}),
+			builder.WithPredicates(memberChanged),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.Education{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.Education)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForMember(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+			builder.WithPredicates(predicates.WorkloadPredicates()),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.Project{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.Project)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForMember(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+			builder.WithPredicates(predicates.WorkloadPredicates()),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.ResumeVariant{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.ResumeVariant)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForMember(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+			builder.WithPredicates(predicates.WorkloadPredicates()),
+		).
 		Build(r)
 	if err != nil {
 		return fmt.Errorf("unable to setup controller, %w", err)
@@ -34,12 +34,24 @@ func (r *ProfileReconciler) InitializePhases() {
 		phases.WithCustomRequeueResult(ctrl.Result{RequeueAfter: 5 * time.Second}),
 	)
 
+	r.Phases.Register(
+		"Delete-Legacy-Resources",
+		DeleteLegacyResourcesPhase,
+		phases.CreateEvent,
+	)
+
 	r.Phases.Register(
 		"Create-Resources",
 		phases.CreateResourcesPhase,
 		phases.CreateEvent,
 	)
 
+	r.Phases.Register(
+		"Record-Render",
+		RecordRenderPhase,
+		phases.CreateEvent,
+	)
+
 	r.Phases.Register(
 		"Check-Ready",
 		phases.CheckReadyPhase,
@@ -61,12 +73,24 @@ func (r *ProfileReconciler) InitializePhases() {
 		phases.WithCustomRequeueResult(ctrl.Result{RequeueAfter: 5 * time.Second}),
 	)
 
+	r.Phases.Register(
+		"Delete-Legacy-Resources",
+		DeleteLegacyResourcesPhase,
+		phases.UpdateEvent,
+	)
+
 	r.Phases.Register(
 		"Create-Resources",
 		phases.CreateResourcesPhase,
 		phases.UpdateEvent,
 	)
 
+	r.Phases.Register(
+		"Record-Render",
+		RecordRenderPhase,
+		phases.UpdateEvent,
+	)
+
 	r.Phases.Register(
 		"Check-Ready",
 		phases.CheckReadyPhase,
@@ -81,6 +105,12 @@ func (r *ProfileReconciler) InitializePhases() {
 	)
 
 	// Delete Phases
+	r.Phases.Register(
+		"Delete-Member-Output",
+		DeleteMemberOutputPhase,
+		phases.DeleteEvent,
+	)
+
 	r.Phases.Register(
 		"DeletionComplete",
 		phases.DeletionCompletePhase,
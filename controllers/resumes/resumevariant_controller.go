@@ -0,0 +1,379 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resumes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/phases"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/predicates"
+	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/variant"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
+	"github.com/jefedavis/resume-operator/internal/dependencies"
+	"github.com/jefedavis/resume-operator/internal/mutate"
+)
+
+// ResumeVariantReconciler reconciles a ResumeVariant object.
+type ResumeVariantReconciler struct {
+	client.Client
+	Name         string
+	Log          logr.Logger
+	Controller   controller.Controller
+	Events       record.EventRecorder
+	FieldManager string
+	Watches      []client.Object
+	Phases       *phases.Registry
+}
+
+func NewResumeVariantReconciler(mgr ctrl.Manager) *ResumeVariantReconciler {
+	return &ResumeVariantReconciler{
+		Name:         "ResumeVariant",
+		Client:       mgr.GetClient(),
+		Events:       mgr.GetEventRecorderFor("ResumeVariant-Controller"),
+		FieldManager: "ResumeVariant-reconciler",
+		Log:          ctrl.Log.WithName("controllers").WithName("resumes").WithName("ResumeVariant"),
+		Watches:      []client.Object{},
+		Phases:       &phases.Registry{},
+	}
+}
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=resumevariants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=resumevariants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=profiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=jobexperiences,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=certifications,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=educations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=resumes.jefedavis.dev,resources=projects,verbs=get;list;watch
+
+// Until Webhooks are implemented we need to list and watch namespaces to ensure
+// they are available before deploying resources,
+// See:
+//   - https://github.com/vmware-tanzu-labs/operator-builder/issues/141
+//   - https://github.com/vmware-tanzu-labs/operator-builder/issues/162
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.7.2/pkg/reconcile
+func (r *ResumeVariantReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	req, err := r.NewRequest(ctx, request)
+	if err != nil {
+		// the collection may be deleted before its members, which are then deleted without it
+		if req != nil && !req.Workload.GetDeletionTimestamp().IsZero() {
+			return r.Phases.HandleExecution(r, req)
+		}
+
+		// the member is reconciled again once its collection is created
+		if errors.Is(err, workload.ErrCollectionNotFound) {
+			return ctrl.Result{}, updateOrphanStatus(r, req)
+		}
+
+		if !apierrs.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if err := phases.RegisterDeleteHooks(r, req); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// execute the phases
+	return r.Phases.HandleExecution(r, req)
+}
+
+func (r *ResumeVariantReconciler) NewRequest(ctx context.Context, request ctrl.Request) (*workload.Request, error) {
+	component := &resumesv1alpha1.ResumeVariant{}
+
+	log := r.Log.WithValues(
+		"kind", component.GetWorkloadGVK().Kind,
+		"name", request.Name,
+		"namespace", request.Namespace,
+	)
+
+	// get the component from the cluster
+	if err := r.Get(ctx, request.NamespacedName, component); err != nil {
+		if !apierrs.IsNotFound(err) {
+			log.Error(err, "unable to fetch workload")
+
+			return nil, fmt.Errorf("unable to fetch workload, %w", err)
+		}
+
+		return nil, err
+	}
+
+	// create the workload request
+	workloadRequest := &workload.Request{
+		Context:  ctx,
+		Workload: component,
+		Log:      log,
+	}
+
+	// store the collection and return any resulting error
+	return workloadRequest, r.SetCollection(component, workloadRequest)
+}
+
+// SetCollection sets the collection for a particular workload request.
+func (r *ResumeVariantReconciler) SetCollection(component *resumesv1alpha1.ResumeVariant, req *workload.Request) error {
+	collection, err := resume.GetCollection(req.Context, r, component.Spec.Collection.Name, component.Spec.Collection.Namespace)
+	if err != nil || collection == nil {
+		if errors.Is(err, workload.ErrCollectionNotFound) {
+			component.SetCollectionCondition(
+				collectionCondition(component, component.Spec.Collection.Name, component.Spec.Collection.Namespace, false),
+			)
+		}
+
+		return fmt.Errorf("unable to set collection, %w", err)
+	}
+
+	// a collection which is being deleted renders no more output for its members, as the
+	// output is deleted along with it
+	if !collection.GetDeletionTimestamp().IsZero() {
+		component.SetCollectionCondition(collectionCondition(component, collection.Name, collection.Namespace, false))
+
+		return fmt.Errorf("unable to set collection, %w", workload.ErrCollectionNotFound)
+	}
+
+	component.SetCollectionCondition(collectionCondition(component, collection.Name, collection.Namespace, true))
+
+	req.Collection = collection
+
+	return nil
+}
+
+// EnqueueRequestsForCollection returns the reconcile requests for the ResumeVariants of a
+// collection, so that the variants are rendered again when the collection or one of its
+// members changes.  A ResumeVariant which does not reference a specific collection belongs
+// to the only collection in the cluster.
+func (r *ResumeVariantReconciler) EnqueueRequestsForCollection(name, namespace string) []reconcile.Request {
+	var collectionList resumesv1beta1.ProfileList
+
+	if err := r.List(context.Background(), &collectionList); err != nil {
+		r.Log.Error(err, "unable to list collection Profile")
+
+		return nil
+	}
+
+	onlyCollection := len(collectionList.Items) == 1
+
+	// a member which does not reference a specific collection belongs to the only collection
+	if name == "" {
+		if !onlyCollection {
+			return nil
+		}
+
+		name, namespace = collectionList.Items[0].Name, collectionList.Items[0].Namespace
+	}
+
+	collection := &resumesv1beta1.Profile{}
+	collection.Name, collection.Namespace = name, namespace
+
+	var resumeVariantList resumesv1alpha1.ResumeVariantList
+
+	if err := r.List(context.Background(), &resumeVariantList, client.InNamespace(namespace)); err != nil {
+		r.Log.Error(err, "unable to list ResumeVariant")
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, component := range resumeVariantList.Items {
+		if resume.IsCollectionMember(collection, component.Spec.Collection.Name, component.Spec.Collection.Namespace, onlyCollection) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      component.Name,
+					Namespace: component.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// GetResources resources runs the methods to properly construct the resources in memory.
+func (r *ResumeVariantReconciler) GetResources(req *workload.Request) ([]client.Object, error) {
+	resourceObjects := []client.Object{}
+
+	component, collection, err := variant.ConvertWorkload(req.Workload, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := resume.ListMembers(req.Context, r, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	// create resources in memory
+	resources, err := variant.Generate(*component, *collection, *members)
+	if err != nil {
+		return nil, err
+	}
+
+	// run through the mutation functions to mutate the resources
+	for _, resource := range resources {
+		mutatedResources, skip, err := r.Mutate(req, resource)
+		if err != nil {
+			return []client.Object{}, err
+		}
+
+		if skip {
+			continue
+		}
+
+		resourceObjects = append(resourceObjects, mutatedResources...)
+	}
+
+	return resourceObjects, nil
+}
+
+// GetEventRecorder returns the event recorder for writing kubernetes events.
+func (r *ResumeVariantReconciler) GetEventRecorder() record.EventRecorder {
+	return r.Events
+}
+
+// GetFieldManager returns the name of the field manager for the controller.
+func (r *ResumeVariantReconciler) GetFieldManager() string {
+	return r.FieldManager
+}
+
+// GetLogger returns the logger from the reconciler.
+func (r *ResumeVariantReconciler) GetLogger() logr.Logger {
+	return r.Log
+}
+
+// GetName returns the name of the reconciler.
+func (r *ResumeVariantReconciler) GetName() string {
+	return r.Name
+}
+
+// GetController returns the controller object associated with the reconciler.
+func (r *ResumeVariantReconciler) GetController() controller.Controller {
+	return r.Controller
+}
+
+// GetWatches returns the objects which are current being watched by the reconciler.
+func (r *ResumeVariantReconciler) GetWatches() []client.Object {
+	return r.Watches
+}
+
+// SetWatch appends a watch to the list of currently watched objects.
+func (r *ResumeVariantReconciler) SetWatch(watch client.Object) {
+	r.Watches = append(r.Watches, watch)
+}
+
+// CheckReady will return whether a component is ready.
+func (r *ResumeVariantReconciler) CheckReady(req *workload.Request) (bool, error) {
+	return dependencies.ResumeVariantCheckReady(r, req)
+}
+
+// Mutate will run the mutate function for the workload.
+func (r *ResumeVariantReconciler) Mutate(
+	req *workload.Request,
+	object client.Object,
+) ([]client.Object, bool, error) {
+	return mutate.ResumeVariantMutate(r, req, object)
+}
+
+func (r *ResumeVariantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.InitializePhases()
+
+	baseController, err := ctrl.NewControllerManagedBy(mgr).
+		WithEventFilter(predicates.WorkloadPredicates()).
+		For(&resumesv1alpha1.ResumeVariant{}).
+		Watches(
+			&source.Kind{Type: &resumesv1beta1.Profile{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				return r.EnqueueRequestsForCollection(object.GetName(), object.GetNamespace())
+			}),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.JobExperience{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.JobExperience)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForCollection(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.Certification{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.Certification)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForCollection(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.Education{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.Education)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForCollection(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+		).
+		Watches(
+			&source.Kind{Type: &resumesv1alpha1.Project{}},
+			handler.EnqueueRequestsFromMapFunc(func(object client.Object) []reconcile.Request {
+				member, ok := object.(*resumesv1alpha1.Project)
+				if !ok {
+					return nil
+				}
+
+				return r.EnqueueRequestsForCollection(member.Spec.Collection.Name, member.Spec.Collection.Namespace)
+			}),
+		).
+		Build(r)
+	if err != nil {
+		return fmt.Errorf("unable to setup controller, %w", err)
+	}
+
+	r.Controller = baseController
+
+	return nil
+}
@@ -3,6 +3,7 @@ module github.com/jefedavis/resume-operator
 go 1.15
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/go-logr/logr v0.4.0
 	github.com/nukleros/operator-builder-tools v0.2.0
 	github.com/onsi/ginkgo v1.16.4
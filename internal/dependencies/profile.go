@@ -17,10 +17,249 @@ limitations under the License.
 package dependencies
 
 import (
+	"fmt"
+
 	"github.com/nukleros/operator-builder-tools/pkg/controller/workload"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 )
 
-// ProfileCheckReady performs the logic to determine if a Profile object is ready.
+// certificateGVK is the kind of the cert-manager Certificate which is created for the TLS
+// secret of the Ingress of a resume.
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// ProfileCheckReady performs the logic to determine if a Profile object is ready.  Each of
+// the workloads which serve the resume is checked, and its readiness is set as a condition
+// on the status of the Profile.  The Profile is ready once none of the conditions is false;
+// a certificate which cannot be checked, as cert-manager is not installed, does not hold it
+// back.
 func ProfileCheckReady(r workload.Reconciler, req *workload.Request) (bool, error) {
-	return true, nil
+	parent, err := resume.ConvertWorkload(req.Workload)
+	if err != nil {
+		return false, err
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := getObject(r, req, resume.ResourceName(parent, "resume"), deployment); err != nil {
+		return false, err
+	}
+
+	endpoints := &corev1.Endpoints{}
+	if err := getObject(r, req, resume.ResourceName(parent, "resume-svc"), endpoints); err != nil {
+		return false, err
+	}
+
+	ingress := &networkingv1.Ingress{}
+	if err := getObject(r, req, resume.ResourceName(parent, "resume"), ingress); err != nil {
+		return false, err
+	}
+
+	certificate, err := getCertificate(r, req, parent)
+	if err != nil {
+		return false, err
+	}
+
+	ready := true
+
+	for _, condition := range []metav1.Condition{
+		DeploymentCondition(deployment),
+		EndpointsCondition(endpoints),
+		IngressCondition(ingress),
+		certificate,
+	} {
+		condition.ObservedGeneration = parent.Generation
+		meta.SetStatusCondition(&parent.Status.ReadinessConditions, condition)
+
+		if condition.Status == metav1.ConditionFalse {
+			ready = false
+		}
+	}
+
+	return ready, nil
+}
+
+// getObject gets an object of the resume from the namespace of the Profile.  An object which
+// is not found is left empty.
+func getObject(r workload.Reconciler, req *workload.Request, name string, object client.Object) error {
+	key := client.ObjectKey{Name: name, Namespace: req.Workload.GetNamespace()}
+
+	if err := r.Get(req.Context, key, object); err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("unable to get %T %s, %w", object, name, err)
+	}
+
+	return nil
+}
+
+// getCertificate returns the readiness condition of the Certificate which cert-manager
+// creates for the TLS secret of the Ingress.
+func getCertificate(r workload.Reconciler, req *workload.Request, parent *resumesv1beta1.Profile) (metav1.Condition, error) {
+	if parent.Spec.CertIssuer == "" {
+		return metav1.Condition{
+			Type:    resumesv1beta1.ConditionCertificateReady,
+			Status:  metav1.ConditionUnknown,
+			Reason:  "NoIssuer",
+			Message: "no certIssuer is set, so no certificate is issued for the ingress",
+		}, nil
+	}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+
+	name := resume.ResourceName(parent, "resume-tls")
+
+	if err := r.Get(req.Context, client.ObjectKey{Name: name, Namespace: parent.Namespace}, certificate); err != nil {
+		switch {
+		case meta.IsNoMatchError(err):
+			return metav1.Condition{
+				Type:    resumesv1beta1.ConditionCertificateReady,
+				Status:  metav1.ConditionUnknown,
+				Reason:  "CertManagerNotInstalled",
+				Message: "the Certificate kind of cert-manager is not installed",
+			}, nil
+		case !apierrs.IsNotFound(err):
+			return metav1.Condition{}, fmt.Errorf("unable to get Certificate %s, %w", name, err)
+		}
+	}
+
+	return CertificateCondition(certificate), nil
+}
+
+// DeploymentCondition returns the readiness condition of the Deployment of a resume.  It is
+// available once the current template has rolled out and all of its replicas are available,
+// so that a pod which is crash looping holds it back.
+func DeploymentCondition(deployment *appsv1.Deployment) metav1.Condition {
+	condition := metav1.Condition{Type: resumesv1beta1.ConditionDeploymentAvailable, Status: metav1.ConditionFalse}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	deploymentStatus := deployment.Status
+
+	switch {
+	case deployment.Name == "":
+		condition.Reason = "NotFound"
+		condition.Message = "the deployment has not been created"
+	case deploymentStatus.ObservedGeneration < deployment.Generation ||
+		deploymentStatus.UpdatedReplicas < replicas ||
+		deploymentStatus.Replicas > deploymentStatus.UpdatedReplicas:
+		condition.Reason = "RollingOut"
+		condition.Message = fmt.Sprintf("deployment %s has %d of %d replicas updated",
+			deployment.Name, deploymentStatus.UpdatedReplicas, replicas)
+	case deploymentStatus.AvailableReplicas < replicas:
+		condition.Reason = "Unavailable"
+		condition.Message = fmt.Sprintf("deployment %s has %d of %d replicas available",
+			deployment.Name, deploymentStatus.AvailableReplicas, replicas)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Available"
+		condition.Message = fmt.Sprintf("deployment %s has %d of %d replicas available",
+			deployment.Name, deploymentStatus.AvailableReplicas, replicas)
+	}
+
+	return condition
+}
+
+// EndpointsCondition returns the readiness condition of the Endpoints of the Service of a
+// resume, which is ready once the Service has an address of a ready pod to send traffic to.
+func EndpointsCondition(endpoints *corev1.Endpoints) metav1.Condition {
+	condition := metav1.Condition{Type: resumesv1beta1.ConditionServiceEndpoints, Status: metav1.ConditionFalse}
+
+	addresses := 0
+	for _, subset := range endpoints.Subsets {
+		addresses += len(subset.Addresses)
+	}
+
+	switch {
+	case endpoints.Name == "":
+		condition.Reason = "NotFound"
+		condition.Message = "the service has no endpoints"
+	case addresses == 0:
+		condition.Reason = "NoReadyEndpoints"
+		condition.Message = fmt.Sprintf("service %s has no ready endpoints", endpoints.Name)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "EndpointsReady"
+		condition.Message = fmt.Sprintf("service %s has %d ready endpoints", endpoints.Name, addresses)
+	}
+
+	return condition
+}
+
+// IngressCondition returns the readiness condition of the Ingress of a resume, which is ready
+// once the ingress controller has assigned it an address.
+func IngressCondition(ingress *networkingv1.Ingress) metav1.Condition {
+	condition := metav1.Condition{Type: resumesv1beta1.ConditionIngressAddress, Status: metav1.ConditionFalse}
+
+	addresses := []string{}
+
+	for _, address := range ingress.Status.LoadBalancer.Ingress {
+		if address.Hostname != "" {
+			addresses = append(addresses, address.Hostname)
+		} else if address.IP != "" {
+			addresses = append(addresses, address.IP)
+		}
+	}
+
+	switch {
+	case ingress.Name == "":
+		condition.Reason = "NotFound"
+		condition.Message = "the ingress has not been created"
+	case len(addresses) == 0:
+		condition.Reason = "NoAddress"
+		condition.Message = fmt.Sprintf("ingress %s has not been assigned an address", ingress.Name)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "AddressAssigned"
+		condition.Message = fmt.Sprintf("ingress %s is served at %v", ingress.Name, addresses)
+	}
+
+	return condition
+}
+
+// CertificateCondition returns the readiness condition of the cert-manager Certificate of
+// the Ingress of a resume, which follows the Ready condition of the Certificate.
+func CertificateCondition(certificate *unstructured.Unstructured) metav1.Condition {
+	condition := metav1.Condition{Type: resumesv1beta1.ConditionCertificateReady, Status: metav1.ConditionFalse}
+
+	if certificate.GetName() == "" {
+		condition.Reason = "NotFound"
+		condition.Message = "the certificate has not been requested"
+
+		return condition
+	}
+
+	condition.Reason = "NotReady"
+	condition.Message = fmt.Sprintf("certificate %s is not ready", certificate.GetName())
+
+	certificateConditions, _, _ := unstructured.NestedSlice(certificate.Object, "status", "conditions")
+
+	for _, certificateCondition := range certificateConditions {
+		fields, ok := certificateCondition.(map[string]interface{})
+		if !ok || fields["type"] != "Ready" {
+			continue
+		}
+
+		if fields["status"] == string(metav1.ConditionTrue) {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "Ready"
+		}
+
+		if message, ok := fields["message"].(string); ok && message != "" {
+			condition.Message = fmt.Sprintf("certificate %s: %s", certificate.GetName(), message)
+		}
+	}
+
+	return condition
 }
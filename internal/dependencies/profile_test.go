@@ -0,0 +1,127 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("Profile readiness", func() {
+	Context("of the Deployment", func() {
+		var deployment *appsv1.Deployment
+
+		BeforeEach(func() {
+			replicas := int32(2)
+
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "jane-resume", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 2,
+					Replicas:           2,
+					UpdatedReplicas:    2,
+					AvailableReplicas:  2,
+				},
+			}
+		})
+
+		It("should be available once all of its replicas are available", func() {
+			condition := DeploymentCondition(deployment)
+			Expect(condition.Type).To(Equal(resumesv1beta1.ConditionDeploymentAvailable))
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("Available"))
+		})
+
+		It("should not be available while a pod is crash looping", func() {
+			deployment.Status.AvailableReplicas = 1
+
+			condition := DeploymentCondition(deployment)
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("Unavailable"))
+		})
+
+		It("should not be available while it is rolling out", func() {
+			deployment.Generation = 3
+
+			Expect(DeploymentCondition(deployment).Reason).To(Equal("RollingOut"))
+
+			deployment.Generation = 2
+			deployment.Status.Replicas = 3
+
+			Expect(DeploymentCondition(deployment).Reason).To(Equal("RollingOut"))
+		})
+
+		It("should not be available before it is created", func() {
+			condition := DeploymentCondition(&appsv1.Deployment{})
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("NotFound"))
+		})
+	})
+
+	It("should check for ready endpoints of the Service", func() {
+		endpoints := &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "jane-resume-svc"}}
+
+		endpoints.Subsets = []corev1.EndpointSubset{
+			{NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}}},
+		}
+		Expect(EndpointsCondition(endpoints).Status).To(Equal(metav1.ConditionFalse))
+		Expect(EndpointsCondition(endpoints).Reason).To(Equal("NoReadyEndpoints"))
+
+		endpoints.Subsets[0].Addresses = []corev1.EndpointAddress{{IP: "10.0.0.2"}}
+		Expect(EndpointsCondition(endpoints).Status).To(Equal(metav1.ConditionTrue))
+		Expect(EndpointsCondition(endpoints).Type).To(Equal(resumesv1beta1.ConditionServiceEndpoints))
+	})
+
+	It("should check for an address of the Ingress", func() {
+		ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "jane-resume"}}
+		Expect(IngressCondition(ingress).Reason).To(Equal("NoAddress"))
+
+		ingress.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}}
+
+		condition := IngressCondition(ingress)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("lb.example.com"))
+	})
+
+	It("should follow the Ready condition of the Certificate", func() {
+		certificate := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		certificate.SetName("jane-resume-tls")
+		Expect(CertificateCondition(certificate).Status).To(Equal(metav1.ConditionFalse))
+
+		readyCondition := map[string]interface{}{"type": "Ready", "status": "False", "message": "Issuing certificate"}
+		Expect(unstructured.SetNestedSlice(certificate.Object, []interface{}{readyCondition}, "status", "conditions")).To(Succeed())
+
+		condition := CertificateCondition(certificate)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Message).To(ContainSubstring("Issuing certificate"))
+
+		readyCondition["status"] = "True"
+		Expect(unstructured.SetNestedSlice(certificate.Object, []interface{}{readyCondition}, "status", "conditions")).To(Succeed())
+		Expect(CertificateCondition(certificate).Status).To(Equal(metav1.ConditionTrue))
+
+		Expect(CertificateCondition(&unstructured.Unstructured{}).Reason).To(Equal("NotFound"))
+	})
+})
@@ -0,0 +1,173 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonresume
+
+import (
+	"path"
+	"strings"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+// Export maps a resume to a JSON Resume, with its members in the order in which they are
+// rendered.  It is the reverse of Import, with the following differences:
+//
+//	baseURL                     basics.url
+//	pageTitle                   basics.label, when the title is "<name> - <label>"
+//	profile.location            basics.location.city and region, split at the first comma
+//	Project members             projects[], with techStack as keywords and role as roles
+//	Education honors            awards[], awarded by the school
+//
+// A position without dates of its own takes the dates of its employer.  An ongoing entry,
+// which ends at the "present" marker, has no end date, and urls which were written without
+// their scheme are exported as https urls.
+func Export(resume *render.Resume) *Resume {
+	resume = resume.Sorted()
+	spec := resume.Profile.Spec
+	name := strings.TrimSpace(spec.Profile.FirstName + " " + spec.Profile.LastName)
+
+	exported := &Resume{
+		Schema: SchemaURL,
+		Basics: Basics{
+			Name:     name,
+			Email:    spec.Profile.Email,
+			URL:      render.Link(spec.BaseURL),
+			Phone:    spec.Profile.PhoneNumber,
+			Summary:  spec.Profile.Overview,
+			Location: exportLocation(spec.Profile.Location),
+		},
+	}
+
+	if label := strings.TrimPrefix(spec.PageTitle, name+" - "); name != "" && label != spec.PageTitle {
+		exported.Basics.Label = label
+	}
+
+	if spec.Profile.LinkedinURL != "" {
+		exported.Basics.Profiles = append(exported.Basics.Profiles, socialProfile("LinkedIn", spec.Profile.LinkedinURL))
+	}
+
+	if spec.Profile.GithubURL != "" {
+		exported.Basics.Profiles = append(exported.Basics.Profiles, socialProfile("GitHub", spec.Profile.GithubURL))
+	}
+
+	for _, family := range spec.Profile.Skills {
+		exported.Skills = append(exported.Skills, Skill{Name: family.Family, Keywords: resumesv1beta1.ItemTexts(family.Items)})
+	}
+
+	for _, competency := range spec.Profile.CoreCompetencies {
+		exported.Skills = append(exported.Skills, Skill{Name: competency})
+	}
+
+	for _, member := range resume.JobExperiences {
+		for _, position := range member.Spec.Positions {
+			exported.Work = append(exported.Work, Work{
+				Name:       member.Spec.Employer,
+				Location:   member.Spec.Location,
+				Position:   position.Title,
+				StartDate:  exportDate(orDate(position.StartDate, member.Spec.StartDate)),
+				EndDate:    exportDate(orDate(position.EndDate, member.Spec.EndDate)),
+				Highlights: resumesv1alpha1.ItemTexts(position.Highlights),
+			})
+		}
+	}
+
+	for _, member := range resume.Educations {
+		exported.Education = append(exported.Education, Education{
+			Institution: member.Spec.School,
+			Area:        member.Spec.FieldOfStudy,
+			StudyType:   member.Spec.Degree,
+			StartDate:   exportDate(member.Spec.StartDate),
+			EndDate:     exportDate(member.Spec.EndDate),
+			Score:       member.Spec.GPA,
+			Courses:     member.Spec.Coursework,
+		})
+
+		for _, honor := range member.Spec.Honors {
+			exported.Awards = append(exported.Awards, Award{Title: honor, Awarder: member.Spec.School})
+		}
+	}
+
+	for _, member := range resume.Certifications {
+		exported.Certificates = append(exported.Certificates, Certificate{
+			Name:   member.Spec.Title,
+			Date:   exportDate(member.Spec.EarnedDate),
+			Issuer: member.Spec.Issuer,
+			URL:    render.Link(member.Spec.ValidationURL),
+		})
+	}
+
+	for _, member := range resume.Projects {
+		project := Project{
+			Name:        member.Spec.Title,
+			Description: member.Spec.Description,
+			Keywords:    member.Spec.TechStack,
+			StartDate:   exportDate(member.Spec.StartDate),
+			EndDate:     exportDate(member.Spec.EndDate),
+			URL:         render.Link(member.Spec.RepoURL),
+		}
+
+		if member.Spec.Role != "" {
+			project.Roles = []string{member.Spec.Role}
+		}
+
+		exported.Projects = append(exported.Projects, project)
+	}
+
+	return exported
+}
+
+// orDate returns the date of a position, or the date of its employer when the position has no
+// date of its own, as a position is rendered within the dates of its employer.
+func orDate(date, fallback resumesv1alpha1.Date) resumesv1alpha1.Date {
+	if date.IsZero() {
+		return fallback
+	}
+
+	return date
+}
+
+// exportDate returns a date as a JSON Resume date, which has no marker for the present.
+func exportDate(date resumesv1alpha1.Date) string {
+	if date.IsPresent() {
+		return ""
+	}
+
+	return date.Normalized()
+}
+
+// exportLocation returns the location of a profile as a city and region.
+func exportLocation(location string) Location {
+	city, region := location, ""
+
+	if i := strings.Index(location, ","); i >= 0 {
+		city, region = location[:i], location[i+1:]
+	}
+
+	return Location{City: strings.TrimSpace(city), Region: strings.TrimSpace(region)}
+}
+
+// socialProfile returns the profile on a social network at a url, with the username taken
+// from the last element of its path.
+func socialProfile(network, url string) SocialProfile {
+	return SocialProfile{
+		Network:  network,
+		Username: path.Base(strings.TrimRight(url, "/")),
+		URL:      render.Link(url),
+	}
+}
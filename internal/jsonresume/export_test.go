@@ -0,0 +1,187 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonresume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+var _ = Describe("Export", func() {
+	var (
+		resume   *render.Resume
+		exported *Resume
+	)
+
+	BeforeEach(func() {
+		imported, err := Parse([]byte(resumeJSON))
+		Expect(err).NotTo(HaveOccurred())
+
+		manifests := Import(imported, "", "resumes")
+
+		resume = &render.Resume{Profile: *manifests.Profile}
+		for _, member := range manifests.JobExperiences {
+			resume.JobExperiences = append(resume.JobExperiences, *member)
+		}
+
+		for _, member := range manifests.Certifications {
+			resume.Certifications = append(resume.Certifications, *member)
+		}
+
+		for _, member := range manifests.Educations {
+			resume.Educations = append(resume.Educations, *member)
+		}
+
+		resume.Profile.Spec.BaseURL = "resume.example.com"
+		resume.Educations[0].Spec.Honors = []string{"Cum Laude"}
+		resume.Projects = []resumesv1alpha1.Project{
+			{
+				Spec: resumesv1alpha1.ProjectSpec{
+					Title:     "homelab",
+					Role:      "Maintainer",
+					TechStack: []string{"Talos"},
+					StartDate: "2022-01",
+					EndDate:   resumesv1alpha1.DatePresent,
+					RepoURL:   "github.com/jdoe/homelab",
+				},
+			},
+		}
+
+		exported = Export(resume)
+	})
+
+	It("should declare the schema of the resume", func() {
+		Expect(exported.Schema).To(Equal(SchemaURL))
+	})
+
+	It("should map the profile to basics", func() {
+		Expect(exported.Basics).To(Equal(Basics{
+			Name:     "Jane Q Doe",
+			Label:    "Platform Engineer",
+			Email:    "jane@example.com",
+			Phone:    "555-0100",
+			URL:      "https://resume.example.com",
+			Summary:  "Builds platforms.",
+			Location: Location{City: "Columbia", Region: "South Carolina"},
+			Profiles: []SocialProfile{
+				{Network: "LinkedIn", Username: "janedoe", URL: "https://www.linkedin.com/in/janedoe"},
+				{Network: "GitHub", Username: "jdoe", URL: "https://github.com/jdoe"},
+			},
+		}))
+	})
+
+	It("should map positions to work, most recent employer first, without the present marker", func() {
+		Expect(exported.Work).To(HaveLen(3))
+		Expect(exported.Work[0]).To(Equal(Work{
+			Name:       "Acme",
+			Position:   "Staff Engineer",
+			StartDate:  "2020-03",
+			Highlights: []string{"Led the platform team"},
+		}))
+		Expect(exported.Work[1].Position).To(Equal("Senior Engineer"))
+		Expect(exported.Work[2].Name).To(Equal("Initech"))
+	})
+
+	It("should export the dates of the employer for a position without dates", func() {
+		resume.JobExperiences = []resumesv1alpha1.JobExperience{
+			{
+				Spec: resumesv1alpha1.JobExperienceSpec{
+					Employer:  "VMware",
+					StartDate: "2021-03-01",
+					EndDate:   resumesv1alpha1.DatePresent,
+					Positions: []resumesv1alpha1.JobExperienceSpecPosition{{Title: "Staff Engineer"}},
+				},
+			},
+			{
+				Spec: resumesv1alpha1.JobExperienceSpec{
+					Employer:  "Epic",
+					StartDate: "2015-06",
+					EndDate:   "2021-02",
+					Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+						{Title: "Senior Engineer", StartDate: "2018-01"},
+						{Title: "Engineer", EndDate: "2017-12"},
+					},
+				},
+			},
+		}
+
+		work := Export(resume).Work
+		Expect(work).To(HaveLen(3))
+		Expect(work[0].StartDate).To(Equal("2021-03-01"))
+		Expect(work[0].EndDate).To(BeEmpty())
+		Expect([]string{work[1].StartDate, work[1].EndDate}).To(Equal([]string{"2018-01", "2021-02"}))
+		Expect([]string{work[2].StartDate, work[2].EndDate}).To(Equal([]string{"2015-06", "2017-12"}))
+	})
+
+	It("should map skill families and core competencies to skills", func() {
+		Expect(exported.Skills).To(Equal([]Skill{
+			{Name: "Languages", Keywords: []string{"Go", "Python"}},
+			{Name: "Mentoring"},
+		}))
+	})
+
+	It("should map education, with its honors as awards", func() {
+		Expect(exported.Education).To(Equal([]Education{
+			{
+				Institution: "State University",
+				Area:        "Computer Science",
+				StudyType:   "B.S.",
+				StartDate:   "2011-08",
+				EndDate:     "2015-05",
+				Score:       "3.8",
+				Courses:     []string{"Compilers"},
+			},
+		}))
+		Expect(exported.Awards).To(Equal([]Award{{Title: "Cum Laude", Awarder: "State University"}}))
+	})
+
+	It("should map certifications to certificates", func() {
+		Expect(exported.Certificates).To(ContainElement(Certificate{
+			Name:   "Certified Kubernetes Administrator",
+			Date:   "2021-04-01",
+			Issuer: "CNCF",
+			URL:    "https://example.com/cka",
+		}))
+	})
+
+	It("should map project members to projects", func() {
+		Expect(exported.Projects).To(Equal([]Project{
+			{
+				Name:      "homelab",
+				Keywords:  []string{"Talos"},
+				StartDate: "2022-01",
+				URL:       "https://github.com/jdoe/homelab",
+				Roles:     []string{"Maintainer"},
+			},
+		}))
+	})
+
+	It("should import what it exports", func() {
+		reimported := Import(exported, "", "resumes")
+
+		// the projects of the resume are the Project members, rather than those of the profile
+		profile := reimported.Profile.Spec.Profile
+		Expect(profile.Projects).To(HaveLen(1))
+		profile.Projects = resume.Profile.Spec.Profile.Projects
+		Expect(profile).To(Equal(resume.Profile.Spec.Profile))
+		Expect(reimported.JobExperiences).To(HaveLen(2))
+		Expect(reimported.JobExperiences[0].Spec).To(Equal(resume.JobExperiences[0].Spec))
+	})
+})
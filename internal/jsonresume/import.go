@@ -0,0 +1,455 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonresume
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// maxNameLength is the length which the names of the manifests are truncated to, so that
+// they remain readable and well within the limits of a resource name.
+const maxNameLength = 63
+
+var (
+	nonName  = regexp.MustCompile(`[^a-z0-9]+`)
+	yearOnly = regexp.MustCompile(`^[0-9]{4}$`)
+)
+
+// Manifests are the manifests of a profile collection which are imported from a JSON Resume.
+type Manifests struct {
+	Profile        *resumesv1beta1.Profile
+	JobExperiences []*resumesv1alpha1.JobExperience
+	Certifications []*resumesv1alpha1.Certification
+	Educations     []*resumesv1alpha1.Education
+
+	// Warnings describe the parts of the JSON Resume which could not be imported.
+	Warnings []string
+}
+
+// Objects returns the manifests in the order which they should be applied, the Profile
+// collection first.
+func (manifests *Manifests) Objects() []client.Object {
+	objects := []client.Object{manifests.Profile}
+
+	for _, member := range manifests.JobExperiences {
+		objects = append(objects, member)
+	}
+
+	for _, member := range manifests.Certifications {
+		objects = append(objects, member)
+	}
+
+	for _, member := range manifests.Educations {
+		objects = append(objects, member)
+	}
+
+	return objects
+}
+
+// Import maps a JSON Resume to the manifests of a profile collection with the given name in
+// the given namespace.  An empty name is derived from basics.name.  The fields are mapped
+// as follows:
+//
+//	basics.name                 profile.firstName (all but the last word) and profile.lastName
+//	basics.label                pageTitle, as "<name> - <label>"
+//	basics.email                profile.email
+//	basics.phone                profile.phoneNumber
+//	basics.summary              profile.overview
+//	basics.location             profile.location, as "<city>, <region>"
+//	basics.profiles             profile.linkedinURL and profile.githubURL, by network
+//	skills[].name, .keywords    profile.skills[].family, .items
+//	skills[].name (no keywords) profile.coreCompetencies
+//	projects[]                  profile.projects[]: name as title, url and description;
+//	                            projects without a url are skipped
+//	work[]                      one JobExperience per employer (name, or company); the
+//	                            entries become its positions in the order they are listed
+//	work[].position             positions[].title
+//	work[].summary, .highlights positions[].highlights, with the summary first
+//	work[].startDate, .endDate  positions[].startDate, .endDate; an entry without an end
+//	                            date is ongoing, and the employer spans its positions
+//	certificates[]              one Certification each, aliased by its name
+//	education[]                 one Education each: institution as school, studyType as
+//	                            degree, area as fieldOfStudy, score as gpa, courses as
+//	                            coursework
+//
+// Dates are kept at the precision they are given, except that a year alone is imported as
+// January of that year.  Dates which are not valid are dropped with a warning.
+func Import(resume *Resume, name, namespace string) *Manifests {
+	return ImportWithOptions(resume, name, namespace, Options{})
+}
+
+// Options change how a JSON Resume is imported.
+type Options struct {
+	// GroupConsecutiveWork groups only the consecutive work entries of an employer as its
+	// positions, so that an employer which is returned to after working elsewhere is imported
+	// as another JobExperience.  It suits a list of work which is ordered by date.
+	GroupConsecutiveWork bool
+}
+
+// ImportWithOptions maps a JSON Resume to the manifests of a profile collection as Import
+// does, changed by the given options.
+func ImportWithOptions(resume *Resume, name, namespace string, options Options) *Manifests {
+	if name == "" {
+		name = slug(resume.Basics.Name)
+	}
+
+	if name == "" {
+		name = "profile"
+	}
+
+	importer := &importer{
+		name:      name,
+		namespace: namespace,
+		options:   options,
+		names:     map[string]bool{name: true},
+		manifests: &Manifests{},
+	}
+
+	importer.profile(resume)
+	importer.work(resume.Work)
+	importer.certificates(resume.Certificates)
+	importer.education(resume.Education)
+
+	return importer.manifests
+}
+
+// importer holds the state of a single import.
+type importer struct {
+	name      string
+	namespace string
+	options   Options
+
+	// names are the names of the manifests which have been imported, so that each is unique
+	names map[string]bool
+
+	manifests *Manifests
+}
+
+// profile imports the basics, skills and projects of the resume as the Profile collection.
+func (i *importer) profile(resume *Resume) {
+	basics := resume.Basics
+	firstName, lastName := splitName(basics.Name)
+
+	profile := &resumesv1beta1.Profile{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: resumesv1beta1.GroupVersion.String(),
+			Kind:       "Profile",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      i.name,
+			Namespace: i.namespace,
+		},
+	}
+
+	profile.Spec.Profile = resumesv1beta1.ProfileSpecProfile{
+		FirstName:   firstName,
+		LastName:    lastName,
+		PhoneNumber: basics.Phone,
+		Email:       basics.Email,
+		Location:    location(basics.Location),
+		Overview:    basics.Summary,
+	}
+
+	if basics.Name != "" && basics.Label != "" {
+		profile.Spec.PageTitle = basics.Name + " - " + basics.Label
+	}
+
+	for _, social := range basics.Profiles {
+		url := social.URL
+
+		switch strings.ToLower(social.Network) {
+		case "linkedin":
+			if url == "" && social.Username != "" {
+				url = "https://www.linkedin.com/in/" + social.Username
+			}
+
+			profile.Spec.Profile.LinkedinURL = url
+		case "github":
+			if url == "" && social.Username != "" {
+				url = "https://github.com/" + social.Username
+			}
+
+			profile.Spec.Profile.GithubURL = url
+		default:
+			i.warn("basics.profiles: %s profile skipped, only LinkedIn and GitHub are supported", social.Network)
+		}
+	}
+
+	for _, skill := range resume.Skills {
+		if len(skill.Keywords) == 0 {
+			profile.Spec.Profile.CoreCompetencies = append(profile.Spec.Profile.CoreCompetencies, skill.Name)
+
+			continue
+		}
+
+		profile.Spec.Profile.Skills = append(profile.Spec.Profile.Skills, resumesv1beta1.ProfileSpecSkillFamily{
+			Family: skill.Name,
+			Items:  resumesv1beta1.TextItems(skill.Keywords...),
+		})
+	}
+
+	for _, project := range resume.Projects {
+		if project.URL == "" {
+			i.warn("projects: %q skipped, a project must have a url", project.Name)
+
+			continue
+		}
+
+		profile.Spec.Profile.Projects = append(profile.Spec.Profile.Projects, resumesv1beta1.ProfileSpecProject{
+			URL:         project.URL,
+			Title:       project.Name,
+			Description: project.Description,
+		})
+	}
+
+	i.manifests.Profile = profile
+}
+
+// work imports the work of the resume as a JobExperience for each employer.  Entries for
+// the same employer are grouped as its positions, even when they are not listed together,
+// unless only consecutive entries are grouped.
+func (i *importer) work(work []Work) {
+	employers := map[string]*resumesv1alpha1.JobExperience{}
+
+	var employerKey string
+
+	for _, entry := range work {
+		employer := entry.Name
+		if employer == "" {
+			employer = entry.Company
+		}
+
+		key := strings.ToLower(strings.TrimSpace(employer))
+
+		// an employer which is returned to is imported again
+		if i.options.GroupConsecutiveWork && key != employerKey {
+			delete(employers, key)
+		}
+
+		member, ok := employers[key]
+		if !ok {
+			member = &resumesv1alpha1.JobExperience{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: resumesv1alpha1.GroupVersion.String(),
+					Kind:       "JobExperience",
+				},
+				ObjectMeta: i.objectMeta(employer),
+			}
+
+			member.Spec.Collection = resumesv1alpha1.JobExperienceCollectionSpec{Name: i.name, Namespace: i.namespace}
+			member.Spec.Employer = employer
+			member.Spec.Location = entry.Location
+
+			employers[key] = member
+			i.manifests.JobExperiences = append(i.manifests.JobExperiences, member)
+		}
+
+		employerKey = key
+
+		field := fmt.Sprintf("work: %s %q", employer, entry.Position)
+		position := resumesv1alpha1.JobExperienceSpecPosition{
+			Title:     entry.Position,
+			StartDate: i.date(field, entry.StartDate),
+			EndDate:   i.date(field, entry.EndDate),
+		}
+
+		if position.EndDate.IsZero() && !position.StartDate.IsZero() {
+			position.EndDate = resumesv1alpha1.DatePresent
+		}
+
+		if entry.Summary != "" {
+			position.Highlights = append(position.Highlights, resumesv1alpha1.TaggedItem{Text: entry.Summary})
+		}
+
+		position.Highlights = append(position.Highlights, resumesv1alpha1.TextItems(entry.Highlights...)...)
+
+		member.Spec.Positions = append(member.Spec.Positions, position)
+		member.Spec.StartDate, member.Spec.EndDate = span(member.Spec.Positions)
+	}
+}
+
+// certificates imports the certificates of the resume as a Certification each.
+func (i *importer) certificates(certificates []Certificate) {
+	aliases := map[string]bool{}
+
+	for _, certificate := range certificates {
+		member := &resumesv1alpha1.Certification{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resumesv1alpha1.GroupVersion.String(),
+				Kind:       "Certification",
+			},
+			ObjectMeta: i.objectMeta(certificate.Name),
+		}
+
+		member.Spec.Collection = resumesv1alpha1.CertificationCollectionSpec{Name: i.name, Namespace: i.namespace}
+		member.Spec.Title = certificate.Name
+		member.Spec.Issuer = certificate.Issuer
+		member.Spec.EarnedDate = i.date(fmt.Sprintf("certificates: %q", certificate.Name), certificate.Date)
+		member.Spec.ValidationURL = certificate.URL
+		member.Spec.Alias = unique(aliases, slug(certificate.Name))
+
+		i.manifests.Certifications = append(i.manifests.Certifications, member)
+	}
+}
+
+// education imports the education of the resume as an Education each.
+func (i *importer) education(education []Education) {
+	for _, entry := range education {
+		member := &resumesv1alpha1.Education{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resumesv1alpha1.GroupVersion.String(),
+				Kind:       "Education",
+			},
+			ObjectMeta: i.objectMeta(entry.Institution),
+		}
+
+		field := fmt.Sprintf("education: %q", entry.Institution)
+
+		member.Spec.Collection = resumesv1alpha1.EducationCollectionSpec{Name: i.name, Namespace: i.namespace}
+		member.Spec.School = entry.Institution
+		member.Spec.Degree = entry.StudyType
+		member.Spec.FieldOfStudy = entry.Area
+		member.Spec.StartDate = i.date(field, entry.StartDate)
+		member.Spec.EndDate = i.date(field, entry.EndDate)
+		member.Spec.GPA = entry.Score
+		member.Spec.Coursework = entry.Courses
+
+		i.manifests.Educations = append(i.manifests.Educations, member)
+	}
+}
+
+// objectMeta returns the metadata of a member, which is named after the collection and the
+// title of the member.
+func (i *importer) objectMeta(title string) metav1.ObjectMeta {
+	name := i.name
+	if suffix := slug(title); suffix != "" {
+		name = strings.Trim(truncate(name+"-"+suffix), "-")
+	}
+
+	return metav1.ObjectMeta{
+		Name:      unique(i.names, name),
+		Namespace: i.namespace,
+	}
+}
+
+// date imports a JSON Resume date, which is ISO 8601 at the precision of a year, month or
+// day.  A date which is not valid is dropped with a warning which names the field.
+func (i *importer) date(field, value string) resumesv1alpha1.Date {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case yearOnly.MatchString(value):
+		value += "-01"
+	case len(value) > len("2006-01-02") && value[len("2006-01-02")] == 'T':
+		value = value[:len("2006-01-02")]
+	}
+
+	date := resumesv1alpha1.Date(value)
+	if err := date.Validate(); err != nil {
+		i.warn("%s: date dropped, %s", field, err)
+
+		return ""
+	}
+
+	return resumesv1alpha1.Date(date.Normalized())
+}
+
+// warn records a part of the resume which could not be imported.
+func (i *importer) warn(format string, args ...interface{}) {
+	i.manifests.Warnings = append(i.manifests.Warnings, fmt.Sprintf(format, args...))
+}
+
+// span returns the dates which an employer spans: the earliest start date of its positions
+// until the latest end date, or the present if any position is ongoing.
+func span(positions []resumesv1alpha1.JobExperienceSpecPosition) (start, end resumesv1alpha1.Date) {
+	for _, position := range positions {
+		if start.IsZero() || position.StartDate.Before(start) {
+			start = position.StartDate
+		}
+
+		if end.IsZero() || end.Before(position.EndDate) {
+			end = position.EndDate
+		}
+	}
+
+	return start, end
+}
+
+// splitName splits a full name into the first name, which is every word but the last, and
+// the last name.
+func splitName(name string) (first, last string) {
+	words := strings.Fields(name)
+	if len(words) < 2 {
+		return name, ""
+	}
+
+	return strings.Join(words[:len(words)-1], " "), words[len(words)-1]
+}
+
+// location returns the location of the basics as a city and region, falling back to the
+// country code when either is not set.
+func location(loc Location) string {
+	parts := []string{}
+
+	for _, part := range []string{loc.City, loc.Region} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	if len(parts) < 2 && loc.CountryCode != "" {
+		parts = append(parts, loc.CountryCode)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// slug returns text as a lower case name of letters, digits and dashes.
+func slug(text string) string {
+	return strings.Trim(truncate(nonName.ReplaceAllString(strings.ToLower(text), "-")), "-")
+}
+
+// truncate returns name truncated to the maximum length of a name.
+func truncate(name string) string {
+	if len(name) > maxNameLength {
+		return name[:maxNameLength]
+	}
+
+	return name
+}
+
+// unique returns name, with a numbered suffix if it has already been used, and records it
+// as used.
+func unique(used map[string]bool, name string) string {
+	candidate := name
+
+	for n := 2; used[candidate]; n++ {
+		candidate = fmt.Sprintf("%s-%d", name, n)
+	}
+
+	used[candidate] = true
+
+	return candidate
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonresume
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+const resumeJSON = `{
+  "basics": {
+    "name": "Jane Q Doe",
+    "label": "Platform Engineer",
+    "email": "jane@example.com",
+    "phone": "555-0100",
+    "summary": "Builds platforms.",
+    "location": {"city": "Columbia", "region": "South Carolina", "countryCode": "US"},
+    "profiles": [
+      {"network": "LinkedIn", "username": "janedoe"},
+      {"network": "GitHub", "url": "https://github.com/jdoe"},
+      {"network": "Twitter", "username": "jdoe"}
+    ]
+  },
+  "work": [
+    {"name": "Acme", "position": "Staff Engineer", "startDate": "2020-03", "highlights": ["Led the platform team"]},
+    {"name": "Initech", "position": "Engineer", "startDate": "2015", "endDate": "2017-06-30", "summary": "Kept the lights on"},
+    {"company": "acme", "position": "Senior Engineer", "startDate": "2017-07-01", "endDate": "2020-02"}
+  ],
+  "certificates": [
+    {"name": "Certified Kubernetes Administrator", "date": "2021-04-01", "issuer": "CNCF", "url": "https://example.com/cka"},
+    {"name": "Certified Kubernetes Administrator", "date": "April 2024", "issuer": "CNCF"}
+  ],
+  "education": [
+    {"institution": "State University", "area": "Computer Science", "studyType": "B.S.", "startDate": "2011-08", "endDate": "2015-05", "score": "3.8", "courses": ["Compilers"]}
+  ],
+  "skills": [
+    {"name": "Languages", "keywords": ["Go", "Python"]},
+    {"name": "Mentoring"}
+  ],
+  "projects": [
+    {"name": "resume-operator", "description": "Resumes on Kubernetes", "url": "https://github.com/jefedavis/resume-operator"},
+    {"name": "secret project"}
+  ]
+}`
+
+var _ = Describe("Import", func() {
+	var manifests *Manifests
+
+	BeforeEach(func() {
+		resume, err := Parse([]byte(resumeJSON))
+		Expect(err).NotTo(HaveOccurred())
+
+		manifests = Import(resume, "", "resumes")
+	})
+
+	It("should reject a document which is not JSON", func() {
+		_, err := Parse([]byte("basics: {}"))
+		Expect(err).To(MatchError(ErrInvalidResume))
+	})
+
+	It("should name the profile after basics.name unless a name is given", func() {
+		Expect(manifests.Profile.Name).To(Equal("jane-q-doe"))
+		Expect(manifests.Profile.Namespace).To(Equal("resumes"))
+		Expect(manifests.Profile.APIVersion).To(Equal(resumesv1beta1.GroupVersion.String()))
+		Expect(manifests.Profile.Kind).To(Equal("Profile"))
+
+		resume, err := Parse([]byte(resumeJSON))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Import(resume, "jane", "resumes").Profile.Name).To(Equal("jane"))
+		Expect(Import(&Resume{}, "", "resumes").Profile.Name).To(Equal("profile"))
+	})
+
+	It("should map basics to the profile", func() {
+		profile := manifests.Profile.Spec.Profile
+		Expect(profile.FirstName).To(Equal("Jane Q"))
+		Expect(profile.LastName).To(Equal("Doe"))
+		Expect(profile.Email).To(Equal("jane@example.com"))
+		Expect(profile.PhoneNumber).To(Equal("555-0100"))
+		Expect(profile.Overview).To(Equal("Builds platforms."))
+		Expect(profile.Location).To(Equal("Columbia, South Carolina"))
+		Expect(profile.LinkedinURL).To(Equal("https://www.linkedin.com/in/janedoe"))
+		Expect(profile.GithubURL).To(Equal("https://github.com/jdoe"))
+		Expect(manifests.Profile.Spec.PageTitle).To(Equal("Jane Q Doe - Platform Engineer"))
+	})
+
+	It("should map skills to skill families and core competencies", func() {
+		profile := manifests.Profile.Spec.Profile
+		Expect(profile.Skills).To(Equal([]resumesv1beta1.ProfileSpecSkillFamily{
+			{Family: "Languages", Items: resumesv1beta1.TextItems("Go", "Python")},
+		}))
+		Expect(profile.CoreCompetencies).To(Equal([]string{"Mentoring"}))
+	})
+
+	It("should map projects with a url to the profile", func() {
+		Expect(manifests.Profile.Spec.Profile.Projects).To(Equal([]resumesv1beta1.ProfileSpecProject{
+			{
+				URL:         "https://github.com/jefedavis/resume-operator",
+				Title:       "resume-operator",
+				Description: "Resumes on Kubernetes",
+			},
+		}))
+	})
+
+	It("should group work by employer into positions", func() {
+		Expect(manifests.JobExperiences).To(HaveLen(2))
+
+		acme := manifests.JobExperiences[0]
+		Expect(acme.Name).To(Equal("jane-q-doe-acme"))
+		Expect(acme.Kind).To(Equal("JobExperience"))
+		Expect(acme.Spec.Collection).To(Equal(resumesv1alpha1.JobExperienceCollectionSpec{Name: "jane-q-doe", Namespace: "resumes"}))
+		Expect(acme.Spec.Employer).To(Equal("Acme"))
+		Expect(acme.Spec.StartDate).To(Equal(resumesv1alpha1.Date("2017-07-01")))
+		Expect(acme.Spec.EndDate).To(Equal(resumesv1alpha1.Date(resumesv1alpha1.DatePresent)))
+		Expect(acme.Spec.Positions).To(Equal([]resumesv1alpha1.JobExperienceSpecPosition{
+			{
+				Title:      "Staff Engineer",
+				StartDate:  "2020-03",
+				EndDate:    resumesv1alpha1.DatePresent,
+				Highlights: resumesv1alpha1.TextItems("Led the platform team"),
+			},
+			{
+				Title:     "Senior Engineer",
+				StartDate: "2017-07-01",
+				EndDate:   "2020-02",
+			},
+		}))
+
+		initech := manifests.JobExperiences[1]
+		Expect(initech.Spec.StartDate).To(Equal(resumesv1alpha1.Date("2015-01")))
+		Expect(initech.Spec.EndDate).To(Equal(resumesv1alpha1.Date("2017-06-30")))
+		Expect(initech.Spec.Positions[0].Highlights).To(Equal(resumesv1alpha1.TextItems("Kept the lights on")))
+	})
+
+	It("should only group consecutive work at an employer when asked to", func() {
+		resume, err := Parse([]byte(resumeJSON))
+		Expect(err).NotTo(HaveOccurred())
+
+		manifests := ImportWithOptions(resume, "", "resumes", Options{GroupConsecutiveWork: true})
+		Expect(manifests.JobExperiences).To(HaveLen(3))
+
+		employers := []string{}
+		for _, member := range manifests.JobExperiences {
+			employers = append(employers, member.Name)
+		}
+
+		Expect(employers).To(Equal([]string{"jane-q-doe-acme", "jane-q-doe-initech", "jane-q-doe-acme-2"}))
+
+		returned := manifests.JobExperiences[2]
+		Expect(returned.Spec.Employer).To(Equal("acme"))
+		Expect(returned.Spec.Positions).To(HaveLen(1))
+		Expect(returned.Spec.Positions[0].Title).To(Equal("Senior Engineer"))
+		Expect(returned.Spec.StartDate).To(Equal(resumesv1alpha1.Date("2017-07-01")))
+		Expect(returned.Spec.EndDate).To(Equal(resumesv1alpha1.Date("2020-02")))
+	})
+
+	It("should map certificates with unique names and aliases", func() {
+		Expect(manifests.Certifications).To(HaveLen(2))
+
+		cka := manifests.Certifications[0]
+		Expect(cka.Name).To(Equal("jane-q-doe-certified-kubernetes-administrator"))
+		Expect(cka.Spec.Collection).To(Equal(resumesv1alpha1.CertificationCollectionSpec{Name: "jane-q-doe", Namespace: "resumes"}))
+		Expect(cka.Spec.Title).To(Equal("Certified Kubernetes Administrator"))
+		Expect(cka.Spec.Issuer).To(Equal("CNCF"))
+		Expect(cka.Spec.EarnedDate).To(Equal(resumesv1alpha1.Date("2021-04-01")))
+		Expect(cka.Spec.ValidationURL).To(Equal("https://example.com/cka"))
+		Expect(cka.Spec.Alias).To(Equal("certified-kubernetes-administrator"))
+
+		renewed := manifests.Certifications[1]
+		Expect(renewed.Name).To(Equal("jane-q-doe-certified-kubernetes-administrator-2"))
+		Expect(renewed.Spec.Alias).To(Equal("certified-kubernetes-administrator-2"))
+		Expect(renewed.Spec.EarnedDate.IsZero()).To(BeTrue())
+	})
+
+	It("should map education", func() {
+		Expect(manifests.Educations).To(HaveLen(1))
+
+		school := manifests.Educations[0].Spec
+		Expect(school.School).To(Equal("State University"))
+		Expect(school.Degree).To(Equal("B.S."))
+		Expect(school.FieldOfStudy).To(Equal("Computer Science"))
+		Expect(school.StartDate).To(Equal(resumesv1alpha1.Date("2011-08")))
+		Expect(school.EndDate).To(Equal(resumesv1alpha1.Date("2015-05")))
+		Expect(school.GPA).To(Equal("3.8"))
+		Expect(school.Coursework).To(Equal([]string{"Compilers"}))
+	})
+
+	It("should warn about the parts which are not imported", func() {
+		Expect(manifests.Warnings).To(ConsistOf(
+			ContainSubstring("Twitter profile skipped"),
+			ContainSubstring(`"secret project" skipped`),
+			ContainSubstring("date dropped"),
+		))
+	})
+
+	It("should return the profile before its members", func() {
+		objects := manifests.Objects()
+		Expect(objects).To(HaveLen(6))
+		Expect(objects[0]).To(Equal(manifests.Profile))
+	})
+})
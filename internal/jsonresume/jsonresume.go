@@ -0,0 +1,142 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonresume maps between the JSON Resume format (https://jsonresume.org/schema/)
+// and the manifests of a profile collection.
+package jsonresume
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidResume = errors.New("invalid json resume")
+
+// SchemaURL is the JSON Schema of the version of JSON Resume which is imported and exported.
+const SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// Resume is a JSON Resume document.  Only the sections which map to a profile collection
+// are read; the others are ignored.
+type Resume struct {
+	Schema       string        `json:"$schema,omitempty"`
+	Basics       Basics        `json:"basics"`
+	Work         []Work        `json:"work,omitempty"`
+	Education    []Education   `json:"education,omitempty"`
+	Awards       []Award       `json:"awards,omitempty"`
+	Certificates []Certificate `json:"certificates,omitempty"`
+	Skills       []Skill       `json:"skills,omitempty"`
+	Projects     []Project     `json:"projects,omitempty"`
+}
+
+// Basics is the basics section of a JSON Resume.
+type Basics struct {
+	Name     string          `json:"name,omitempty"`
+	Label    string          `json:"label,omitempty"`
+	Email    string          `json:"email,omitempty"`
+	Phone    string          `json:"phone,omitempty"`
+	URL      string          `json:"url,omitempty"`
+	Summary  string          `json:"summary,omitempty"`
+	Location Location        `json:"location,omitempty"`
+	Profiles []SocialProfile `json:"profiles,omitempty"`
+}
+
+// Location is the location of the basics section of a JSON Resume.
+type Location struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+// SocialProfile is a profile on a social network, e.g. LinkedIn or GitHub.
+type SocialProfile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// Work is a position held at an employer.  Company is the name of the employer in versions
+// of the schema before 1.0.0, which is read when Name is not set.
+type Work struct {
+	Name       string   `json:"name,omitempty"`
+	Company    string   `json:"company,omitempty"`
+	Location   string   `json:"location,omitempty"`
+	Position   string   `json:"position,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// Education is a course of study at an institution.
+type Education struct {
+	Institution string   `json:"institution,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	StudyType   string   `json:"studyType,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Score       string   `json:"score,omitempty"`
+	Courses     []string `json:"courses,omitempty"`
+}
+
+// Award is an award which was received.
+type Award struct {
+	Title   string `json:"title,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Awarder string `json:"awarder,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// Certificate is a certificate which was earned.
+type Certificate struct {
+	Name   string `json:"name,omitempty"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// Skill is a family of skills, with the skills themselves as its keywords.
+type Skill struct {
+	Name     string   `json:"name,omitempty"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// Project is a project which was worked on.
+type Project struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+}
+
+// Parse parses a JSON Resume document.
+func Parse(document []byte) (*Resume, error) {
+	var resume Resume
+	if err := json.Unmarshal(document, &resume); err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidResume, err)
+	}
+
+	return &resume, nil
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package linkedin imports the data export archive of LinkedIn ("Get a copy of your data")
+// as the manifests of a profile collection.
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jefedavis/resume-operator/internal/jsonresume"
+)
+
+var ErrInvalidArchive = errors.New("invalid linkedin archive")
+
+// dateLayouts are the layouts of the dates in the archive, along with the layout of the
+// JSON Resume date which each is converted to.
+var dateLayouts = [][2]string{
+	{"Jan 2006", "2006-01"},
+	{"Jan 2, 2006", "2006-01-02"},
+	{"2006-01-02", "2006-01-02"},
+	{"2006", "2006"},
+}
+
+// Import reads a LinkedIn data export archive into the manifests of a profile collection
+// with the given name in the given namespace.  The archive is read as a JSON Resume, and
+// imported with the same mapping as jsonresume.Import, from the following files:
+//
+//	Profile.csv                 basics: First Name and Last Name as the name, Headline as the
+//	                            label, Summary, Geo Location, and a GitHub url in Websites
+//	Email Addresses.csv         basics.email, the primary address
+//	PhoneNumbers.csv            basics.phone, the first number
+//	Positions.csv               work: Company Name, Title, Location, Started On, Finished On,
+//	                            with each line of the Description as a highlight
+//	Certifications.csv          certificates: Name, Authority, Url, Started On
+//	Education.csv               education: School Name, Degree Name, Start Date, End Date
+//	Projects.csv                projects: Title, Description, Url
+//	Skills.csv                  skills: a single "Skills" family of every Name
+//
+// The positions are listed most recent first, so that only consecutive positions at the same
+// company are grouped into one JobExperience, and a company which is returned to after working
+// elsewhere is imported as another.  A file which is not in the archive is skipped.
+func Import(archive *zip.Reader, name, namespace string) (*jsonresume.Manifests, error) {
+	reader := &archiveReader{archive: archive}
+
+	resume, firstName, lastName := reader.resume()
+	if reader.err != nil {
+		return nil, reader.err
+	}
+
+	manifests := jsonresume.ImportWithOptions(resume, name, namespace, jsonresume.Options{GroupConsecutiveWork: true})
+
+	// the archive names the person in parts, which are kept rather than split again
+	manifests.Profile.Spec.Profile.FirstName = firstName
+	manifests.Profile.Spec.Profile.LastName = lastName
+
+	return manifests, nil
+}
+
+// archiveReader reads the files of an archive, holding the first error which occurs.
+type archiveReader struct {
+	archive *zip.Reader
+	err     error
+}
+
+// resume reads the archive as a JSON Resume, along with the first and last name from the
+// profile.
+func (r *archiveReader) resume() (resume *jsonresume.Resume, firstName, lastName string) {
+	resume = &jsonresume.Resume{}
+
+	for _, record := range r.records("Profile.csv") {
+		firstName, lastName = record["First Name"], record["Last Name"]
+
+		resume.Basics.Name = strings.TrimSpace(firstName + " " + lastName)
+		resume.Basics.Label = record["Headline"]
+		resume.Basics.Summary = record["Summary"]
+		resume.Basics.Location.City = record["Geo Location"]
+		resume.Basics.Profiles = websites(record["Websites"])
+	}
+
+	for _, record := range r.records("Email Addresses.csv") {
+		if resume.Basics.Email == "" || strings.EqualFold(record["Primary"], "yes") {
+			resume.Basics.Email = record["Email Address"]
+		}
+	}
+
+	for _, record := range r.records("PhoneNumbers.csv") {
+		if resume.Basics.Phone == "" {
+			resume.Basics.Phone = record["Number"]
+		}
+	}
+
+	for _, record := range r.records("Positions.csv") {
+		resume.Work = append(resume.Work, jsonresume.Work{
+			Name:       record["Company Name"],
+			Position:   record["Title"],
+			Location:   record["Location"],
+			StartDate:  date(record["Started On"]),
+			EndDate:    date(record["Finished On"]),
+			Highlights: highlights(record["Description"]),
+		})
+	}
+
+	for _, record := range r.records("Certifications.csv") {
+		resume.Certificates = append(resume.Certificates, jsonresume.Certificate{
+			Name:   record["Name"],
+			Date:   date(record["Started On"]),
+			Issuer: record["Authority"],
+			URL:    record["Url"],
+		})
+	}
+
+	for _, record := range r.records("Education.csv") {
+		resume.Education = append(resume.Education, jsonresume.Education{
+			Institution: record["School Name"],
+			StudyType:   record["Degree Name"],
+			StartDate:   date(record["Start Date"]),
+			EndDate:     date(record["End Date"]),
+		})
+	}
+
+	for _, record := range r.records("Projects.csv") {
+		resume.Projects = append(resume.Projects, jsonresume.Project{
+			Name:        record["Title"],
+			Description: record["Description"],
+			URL:         record["Url"],
+		})
+	}
+
+	skills := jsonresume.Skill{Name: "Skills"}
+	for _, record := range r.records("Skills.csv") {
+		skills.Keywords = append(skills.Keywords, record["Name"])
+	}
+
+	if len(skills.Keywords) > 0 {
+		resume.Skills = append(resume.Skills, skills)
+	}
+
+	return resume, firstName, lastName
+}
+
+// records returns the records of a CSV file in the archive, each by the names of the columns
+// in the header of the file.  A file which is not in the archive has no records.
+func (r *archiveReader) records(filename string) []map[string]string {
+	if r.err != nil {
+		return nil
+	}
+
+	for _, file := range r.archive.File {
+		if !strings.EqualFold(path.Base(file.Name), filename) {
+			continue
+		}
+
+		records, err := readCSV(file)
+		if err != nil {
+			r.err = fmt.Errorf("%w, unable to read %s, %s", ErrInvalidArchive, file.Name, err)
+		}
+
+		return records
+	}
+
+	return nil
+}
+
+// readCSV reads the records of a CSV file, which may begin with a byte order mark.
+func readCSV(file *zip.File) ([]map[string]string, error) {
+	contents, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	defer contents.Close()
+
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	rows, err := reader.ReadAll()
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+
+	header, records := rows[0], []map[string]string{}
+
+	for _, row := range rows[1:] {
+		record := map[string]string{}
+
+		for i, value := range row {
+			if i < len(header) {
+				record[strings.TrimSpace(header[i])] = strings.TrimSpace(value)
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// date returns a date of the archive, e.g. "Jan 2020", as a JSON Resume date.  A date which
+// is not in a known layout is returned unchanged, for the import to report.
+func date(value string) string {
+	for _, layouts := range dateLayouts {
+		if parsed, err := time.Parse(layouts[0], value); err == nil {
+			return parsed.Format(layouts[1])
+		}
+	}
+
+	return value
+}
+
+// highlights returns each line of a description as a highlight, without the bullet which
+// it may have been written with.
+func highlights(description string) []string {
+	lines := []string{}
+
+	for _, line := range strings.Split(description, "\n") {
+		if line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "•*-–")); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// websites returns the GitHub profile from the websites of a profile, which are listed as
+// "[TYPE:url,TYPE:url]".  The other websites do not map to the profile.
+func websites(value string) []jsonresume.SocialProfile {
+	profiles := []jsonresume.SocialProfile{}
+
+	for _, website := range strings.Split(strings.Trim(value, "[]"), ",") {
+		if i := strings.Index(website, ":"); i >= 0 && !strings.HasPrefix(website[i+1:], "//") {
+			website = website[i+1:]
+		}
+
+		if strings.Contains(strings.ToLower(website), "github.com") {
+			profiles = append(profiles, jsonresume.SocialProfile{Network: "GitHub", URL: strings.TrimSpace(website)})
+		}
+	}
+
+	return profiles
+}
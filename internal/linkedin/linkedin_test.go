@@ -0,0 +1,153 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/jsonresume"
+)
+
+// archiveFiles are the files of a LinkedIn data export archive, as LinkedIn writes them.
+var archiveFiles = map[string]string{
+	"Profile.csv": "\ufeffFirst Name,Last Name,Maiden Name,Address,Birth Date,Headline,Summary,Industry,Zip Code,Geo Location,Twitter Handles,Websites,Instant Messengers\n" +
+		`Mary Ann,Van Dyke,,,,Platform Engineer,Builds platforms.,Software,,"Columbia, South Carolina",,"[PERSONAL:https://example.com,OTHER:https://github.com/mvandyke]",` + "\n",
+	"Email Addresses.csv": "Email Address,Confirmed,Primary,Updated On\n" +
+		"old@example.com,Yes,No,1/1/20\n" +
+		"mary@example.com,Yes,Yes,1/1/21\n",
+	"PhoneNumbers.csv": "Extension,Number,Type\n,555-0100,Mobile\n",
+	"Positions.csv": "Company Name,Title,Description,Location,Started On,Finished On\n" +
+		`Acme,Staff Engineer,"• Led the platform team` + "\n" + `• Cut costs by half",Remote,Mar 2020,` + "\n" +
+		"Acme,Senior Engineer,,Remote,Jul 2017,Feb 2020\n" +
+		"Initech,Engineer,Kept the lights on,Austin,2015,Jun 2017\n" +
+		"Acme,Intern,,Remote,May 2014,Aug 2014\n",
+	"Certifications.csv": "Name,Url,Authority,Started On,Finished On,License Number\n" +
+		"Certified Kubernetes Administrator,https://example.com/cka,CNCF,Apr 2021,Apr 2024,LF-123\n",
+	"Education.csv": "School Name,Start Date,End Date,Notes,Degree Name,Activities\n" +
+		"State University,2011,2015,,Bachelor of Science,\n",
+	"Skills.csv":      "Name\nGo\nKubernetes\n",
+	"Connections.csv": "Notes:\n\"Connections are not imported\"\n",
+}
+
+// archive returns a zip archive of files.
+func archive(files map[string]string) *zip.Reader {
+	var buffer bytes.Buffer
+
+	writer := zip.NewWriter(&buffer)
+	for name, contents := range files {
+		file, err := writer.Create("Basic_LinkedInDataExport/" + name)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = file.Write([]byte(contents))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(writer.Close()).To(Succeed())
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	Expect(err).NotTo(HaveOccurred())
+
+	return reader
+}
+
+var _ = Describe("Import", func() {
+	var manifests *jsonresume.Manifests
+
+	BeforeEach(func() {
+		var err error
+
+		manifests, err = Import(archive(archiveFiles), "", "resumes")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should map the profile, keeping the parts of the name", func() {
+		Expect(manifests.Profile.Name).To(Equal("mary-ann-van-dyke"))
+		Expect(manifests.Profile.Spec.PageTitle).To(Equal("Mary Ann Van Dyke - Platform Engineer"))
+		Expect(manifests.Profile.Spec.Profile).To(Equal(resumesv1beta1.ProfileSpecProfile{
+			FirstName:   "Mary Ann",
+			LastName:    "Van Dyke",
+			PhoneNumber: "555-0100",
+			Email:       "mary@example.com",
+			GithubURL:   "https://github.com/mvandyke",
+			Location:    "Columbia, South Carolina",
+			Overview:    "Builds platforms.",
+			Skills: []resumesv1beta1.ProfileSpecSkillFamily{
+				{Family: "Skills", Items: resumesv1beta1.TextItems("Go", "Kubernetes")},
+			},
+		}))
+	})
+
+	It("should group consecutive positions at the same company", func() {
+		Expect(manifests.JobExperiences).To(HaveLen(3))
+
+		acme := manifests.JobExperiences[0].Spec
+		Expect(acme.Employer).To(Equal("Acme"))
+		Expect(acme.Location).To(Equal("Remote"))
+		Expect(acme.StartDate).To(Equal(resumesv1alpha1.Date("2017-07")))
+		Expect(acme.EndDate).To(Equal(resumesv1alpha1.Date(resumesv1alpha1.DatePresent)))
+		Expect(acme.Positions).To(Equal([]resumesv1alpha1.JobExperienceSpecPosition{
+			{
+				Title:      "Staff Engineer",
+				StartDate:  "2020-03",
+				EndDate:    resumesv1alpha1.DatePresent,
+				Highlights: resumesv1alpha1.TextItems("Led the platform team", "Cut costs by half"),
+			},
+			{
+				Title:     "Senior Engineer",
+				StartDate: "2017-07",
+				EndDate:   "2020-02",
+			},
+		}))
+
+		Expect(manifests.JobExperiences[1].Spec.Employer).To(Equal("Initech"))
+		Expect(manifests.JobExperiences[1].Spec.StartDate).To(Equal(resumesv1alpha1.Date("2015-01")))
+		Expect(manifests.JobExperiences[2].Spec.Employer).To(Equal("Acme"))
+		Expect(manifests.JobExperiences[2].Spec.Positions).To(HaveLen(1))
+	})
+
+	It("should map certifications", func() {
+		Expect(manifests.Certifications).To(HaveLen(1))
+		Expect(manifests.Certifications[0].Spec).To(Equal(resumesv1alpha1.CertificationSpec{
+			Collection:    resumesv1alpha1.CertificationCollectionSpec{Name: "mary-ann-van-dyke", Namespace: "resumes"},
+			Title:         "Certified Kubernetes Administrator",
+			Issuer:        "CNCF",
+			EarnedDate:    "2021-04",
+			Alias:         "certified-kubernetes-administrator",
+			ValidationURL: "https://example.com/cka",
+		}))
+	})
+
+	It("should map education", func() {
+		Expect(manifests.Educations).To(HaveLen(1))
+		Expect(manifests.Educations[0].Spec.School).To(Equal("State University"))
+		Expect(manifests.Educations[0].Spec.Degree).To(Equal("Bachelor of Science"))
+		Expect(manifests.Educations[0].Spec.StartDate).To(Equal(resumesv1alpha1.Date("2011-01")))
+	})
+
+	It("should import an archive without some of the files", func() {
+		manifests, err := Import(archive(map[string]string{"Skills.csv": "Name\nGo\n"}), "jane", "resumes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifests.Profile.Name).To(Equal("jane"))
+		Expect(manifests.JobExperiences).To(BeEmpty())
+	})
+})
@@ -0,0 +1,179 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"strings"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// atsStyle is the style of a block of an ATS document.
+type atsStyle int
+
+const (
+	atsName atsStyle = iota
+	atsContact
+	atsHeading
+	atsEntry
+	atsDetail
+	atsParagraph
+	atsBullet
+)
+
+// atsBlock is a block of text of an ATS document, which is a single line or paragraph.
+type atsBlock struct {
+	style atsStyle
+	text  string
+}
+
+// atsBlocks lays out the resume for applicant tracking systems, which read a document from top
+// to bottom: a single column of plain text under the section headings that they recognize,
+// without icons, tables or links behind text.
+func atsBlocks(resume *Resume) []atsBlock {
+	profile := resume.Profile.Spec.Profile
+	blocks := []atsBlock{}
+
+	add := func(style atsStyle, text string) {
+		if text = strings.TrimSpace(text); text != "" {
+			blocks = append(blocks, atsBlock{style, text})
+		}
+	}
+
+	add(atsName, profile.FirstName+" "+profile.LastName)
+	add(atsContact, contacts(resume, " | "))
+
+	if profile.Overview != "" {
+		add(atsHeading, "Summary")
+		add(atsParagraph, profile.Overview)
+	}
+
+	if len(profile.CoreCompetencies) > 0 || len(profile.Skills) > 0 {
+		add(atsHeading, "Skills")
+
+		for _, competency := range profile.CoreCompetencies {
+			add(atsBullet, competency)
+		}
+
+		for _, family := range profile.Skills {
+			add(atsBullet, family.Family+": "+strings.Join(resumesv1beta1.ItemTexts(family.Items), ", "))
+		}
+	}
+
+	if len(resume.JobExperiences) > 0 {
+		add(atsHeading, "Experience")
+
+		for _, jobExperience := range resume.JobExperiences {
+			for _, position := range jobExperience.Spec.Positions {
+				add(atsEntry, joinSet(", ", position.Title, jobExperience.Spec.Employer))
+
+				dates := dateRange(position.StartDate, position.EndDate)
+				if dates == "" {
+					dates = dateRange(jobExperience.Spec.StartDate, jobExperience.Spec.EndDate)
+				}
+
+				add(atsDetail, joinSet(" | ", jobExperience.Spec.Location, dates))
+
+				for _, highlight := range position.Highlights {
+					add(atsBullet, highlight.Text)
+				}
+			}
+		}
+	}
+
+	if len(resume.Projects) > 0 {
+		add(atsHeading, "Projects")
+
+		for _, project := range resume.Projects {
+			add(atsEntry, project.Spec.Title)
+			add(atsDetail, Link(project.Spec.RepoURL))
+			add(atsParagraph, project.Spec.Description)
+
+			if len(project.Spec.TechStack) > 0 {
+				add(atsParagraph, "Technologies: "+strings.Join(project.Spec.TechStack, ", "))
+			}
+		}
+	}
+
+	if len(resume.Educations) > 0 {
+		add(atsHeading, "Education")
+
+		for _, education := range resume.Educations {
+			add(atsEntry, joinSet(", ", education.Spec.Degree, education.Spec.FieldOfStudy))
+			add(atsDetail, joinSet(" | ", education.Spec.School, dateRange(education.Spec.StartDate, education.Spec.EndDate)))
+		}
+	}
+
+	if len(resume.Certifications) > 0 {
+		add(atsHeading, "Certifications")
+
+		for _, certification := range resume.Certifications {
+			earned := ""
+			if !certification.Spec.EarnedDate.IsZero() {
+				earned = certification.Spec.EarnedDate.Display()
+			}
+
+			add(atsEntry, joinSet(", ", certification.Spec.Title, certification.Spec.Issuer))
+			add(atsDetail, joinSet(" | ", earned, Link(certification.Spec.ValidationURL)))
+		}
+	}
+
+	return blocks
+}
+
+// ATSText renders the resume as plain text for applicant tracking systems, in a single column
+// under the section headings which they recognize.
+func ATSText(resume *Resume) ([]byte, error) {
+	var document bytes.Buffer
+
+	previous := atsName
+
+	for _, block := range atsBlocks(resume.Sorted()) {
+		if block.style == atsHeading || (block.style == atsEntry && previous != atsHeading) {
+			document.WriteString("\n")
+		}
+
+		previous = block.style
+
+		switch block.style {
+		case atsHeading:
+			document.WriteString(strings.ToUpper(block.text))
+		case atsBullet:
+			document.WriteString("- " + block.text)
+		default:
+			document.WriteString(block.text)
+		}
+
+		document.WriteString("\n")
+	}
+
+	return document.Bytes(), nil
+}
+
+// joinSet joins the values which are set with sep.
+func joinSet(sep string, values ...string) string {
+	set := []string{}
+
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			set = append(set, value)
+		}
+	}
+
+	return strings.Join(set, sep)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var _ = Describe("DOCX", func() {
+	var resume *Resume
+
+	BeforeEach(func() {
+		resume = &Resume{
+			Profile: resumesv1beta1.Profile{
+				Spec: resumesv1beta1.ProfileSpec{
+					PageTitle: "Jane Doe - CV",
+					Profile: resumesv1beta1.ProfileSpecProfile{
+						FirstName: "Jane",
+						LastName:  "Doe",
+						Email:     "jane@example.com",
+						Skills: []resumesv1beta1.ProfileSpecSkillFamily{
+							{Family: "Languages", Items: resumesv1beta1.TextItems("Go", "C++")},
+						},
+					},
+				},
+			},
+			JobExperiences: []resumesv1alpha1.JobExperience{
+				{
+					Spec: resumesv1alpha1.JobExperienceSpec{
+						Employer:  "Acme & Sons",
+						StartDate: "2020-01",
+						Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+							{Title: "SRE", Highlights: resumesv1alpha1.TextItems("Cut costs by <50%>")},
+						},
+					},
+				},
+			},
+			Certifications: []resumesv1alpha1.Certification{
+				{Spec: resumesv1alpha1.CertificationSpec{Title: "CKA", Issuer: "CNCF"}},
+			},
+		}
+	})
+
+	// documentXML returns the main part of a DOCX.
+	documentXML := func(docx []byte) string {
+		archive, err := zip.NewReader(bytes.NewReader(docx), int64(len(docx)))
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, file := range archive.File {
+			Expect(file.Modified.Year()).To(Equal(1980))
+
+			if file.Name != "word/document.xml" {
+				continue
+			}
+
+			reader, err := file.Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			document, err := io.ReadAll(reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			return string(document)
+		}
+
+		Fail("word/document.xml is not in the DOCX")
+
+		return ""
+	}
+
+	It("renders the ATS text as a single column of plain text", func() {
+		text, err := ATSText(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(text)).To(Equal("Jane Doe\njane@example.com\n\n" +
+			"SKILLS\n- Languages: Go, C++\n\n" +
+			"EXPERIENCE\nSRE, Acme & Sons\nJan 2020\n- Cut costs by <50%>\n\n" +
+			"CERTIFICATIONS\nCKA, CNCF\n"))
+	})
+
+	It("renders the same single column of text as the ATS text", func() {
+		docx, err := DOCX(resume)
+		Expect(err).NotTo(HaveOccurred())
+
+		document := documentXML(docx)
+		Expect(xml.Unmarshal([]byte(document), new(interface{}))).To(Succeed())
+		Expect(document).To(ContainSubstring(`<w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t xml:space="preserve">Experience</w:t>`))
+		Expect(document).To(ContainSubstring(`SRE, Acme &amp; Sons`))
+		Expect(document).To(ContainSubstring(`<w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">Cut costs by &lt;50%&gt;</w:t>`))
+
+		again, err := DOCX(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(docx))
+	})
+})
@@ -0,0 +1,472 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+	"strings"
+
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// The PDF is laid out on US Letter pages, in points.
+const (
+	pdfPageWidth  = 612.0
+	pdfPageHeight = 792.0
+	pdfMargin     = 48.0
+)
+
+// pdfScales are the scales which the PDF is laid out at, largest first, until the resume
+// fits within the page count of the Profile.
+var pdfScales = []float64{1, 0.95, 0.9, 0.85, 0.8, 0.75, 0.7}
+
+// PDF renders the resume as a PDF document.  The document is set in the standard Helvetica
+// fonts, so that no fonts need to be embedded, and is scaled down until it fits within the
+// page count of the Profile.  Content which does not fit at the smallest scale is cut off
+// at the end of the last page, which PDFWithWarnings reports.
+func PDF(resume *Resume) ([]byte, error) {
+	document, _, err := PDFWithWarnings(resume)
+
+	return document, err
+}
+
+// PDFWithWarnings renders the resume as a PDF document as PDF does, along with warnings which
+// describe the content of the resume that the document leaves out.
+func PDFWithWarnings(resume *Resume) ([]byte, []string, error) {
+	pageCount := resume.Profile.Spec.PageCount
+	if pageCount < 1 {
+		pageCount = resumesv1beta1.DefaultPageCount
+	}
+
+	sorted := resume.Sorted()
+
+	var layout *pdfLayout
+
+	for _, scale := range pdfScales {
+		layout = newPDFLayout(scale, resume.Profile.Spec.Theme)
+		layout.resume(sorted)
+
+		if len(layout.pages) <= pageCount {
+			break
+		}
+	}
+
+	var warnings []string
+
+	if len(layout.pages) > pageCount {
+		warnings = append(warnings, fmt.Sprintf(
+			"the resume takes %d pages at the smallest scale, more than its pageCount of %d, so the PDF is cut off at the end of page %d",
+			len(layout.pages), pageCount, pageCount))
+
+		layout.pages = layout.pages[:pageCount]
+	}
+
+	document, err := layout.document(resume.Profile.Spec.PageTitle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return document, warnings, nil
+}
+
+// PDFPages returns the number of pages which the resume takes in its PDF at full scale,
+// before it is scaled down or cut off to fit within the page count of the Profile.
+func PDFPages(resume *Resume) int {
+	layout := newPDFLayout(pdfScales[0], resume.Profile.Spec.Theme)
+	layout.resume(resume.Sorted())
+
+	return len(layout.pages)
+}
+
+// pdfLayout places the content of a resume onto pages.
+type pdfLayout struct {
+	scale float64
+	pages []*bytes.Buffer
+
+	// headingColor and ruleColor are the fill and stroke colors of the headings, as the
+	// red, green and blue operands of a PDF color operator.
+	headingColor string
+	ruleColor    string
+
+	// y is the distance from the top of the current page to the top of the next line.
+	y float64
+}
+
+func newPDFLayout(scale float64, theme resumesv1beta1.ProfileSpecTheme) *pdfLayout {
+	colors := theme.ResolvedColors()
+
+	layout := &pdfLayout{
+		scale:        scale,
+		headingColor: pdfColor(colors.Header, "0.24 0.46 0.16"),
+		ruleColor:    pdfColor(colors.IconBackground, "0.59 0.73 0.53"),
+	}
+	layout.newPage()
+
+	return layout
+}
+
+// resume lays out each section of the resume.
+func (layout *pdfLayout) resume(resume *Resume) {
+	profile := resume.Profile.Spec.Profile
+
+	layout.text(helveticaBold, 22, 0, profile.FirstName+" "+profile.LastName)
+
+	contacts := []string{}
+
+	for _, contact := range []string{profile.PhoneNumber, profile.Email, profile.LinkedinURL, profile.GithubURL, profile.Location} {
+		if contact != "" {
+			contacts = append(contacts, contact)
+		}
+	}
+
+	layout.paragraph(helvetica, 9.5, 0, strings.Join(contacts, "  •  "))
+
+	if profile.Overview != "" {
+		layout.heading("Overview")
+		layout.paragraph(helvetica, 10, 0, profile.Overview)
+	}
+
+	if len(profile.CoreCompetencies) > 0 {
+		layout.heading("Core Competencies")
+		layout.paragraph(helvetica, 10, 0, strings.Join(profile.CoreCompetencies, "  •  "))
+	}
+
+	if len(profile.Skills) > 0 {
+		layout.heading("Skills")
+
+		for _, family := range profile.Skills {
+			layout.labelled(family.Family, strings.Join(resumesv1beta1.ItemTexts(family.Items), ", "))
+		}
+	}
+
+	if len(resume.JobExperiences) > 0 {
+		layout.heading("Experience")
+
+		for _, experience := range resume.JobExperiences {
+			layout.row(helveticaBold, 11, experience.Spec.Employer, dateRange(experience.Spec.StartDate, experience.Spec.EndDate))
+
+			if experience.Spec.Location != "" {
+				layout.paragraph(helvetica, 9.5, 0, experience.Spec.Location)
+			}
+
+			for _, position := range experience.Spec.Positions {
+				layout.row(helveticaBold, 10, position.Title, dateRange(position.StartDate, position.EndDate))
+
+				for _, highlight := range position.Highlights {
+					layout.bullet(highlight.Text)
+				}
+			}
+
+			layout.space(4)
+		}
+	}
+
+	if len(resume.Certifications) > 0 {
+		layout.heading("Certifications")
+
+		for _, certification := range resume.Certifications {
+			title := certification.Spec.Title
+			if certification.Spec.Issuer != "" {
+				title += ", " + certification.Spec.Issuer
+			}
+
+			layout.row(helvetica, 10, title, certification.Spec.EarnedDate.Display())
+		}
+	}
+
+	if len(resume.Educations) > 0 {
+		layout.heading("Education")
+
+		for _, education := range resume.Educations {
+			degree := education.Spec.Degree
+			if education.Spec.FieldOfStudy != "" {
+				degree += ", " + education.Spec.FieldOfStudy
+			}
+
+			layout.row(helveticaBold, 10, degree, dateRange(education.Spec.StartDate, education.Spec.EndDate))
+			layout.paragraph(helvetica, 10, 0, education.Spec.School)
+		}
+	}
+
+	if len(resume.Projects) > 0 {
+		layout.heading("Projects")
+
+		for _, project := range resume.Projects {
+			layout.row(helveticaBold, 10, project.Spec.Title, project.Spec.RepoURL)
+
+			if project.Spec.Description != "" {
+				layout.paragraph(helvetica, 10, 0, project.Spec.Description)
+			}
+		}
+	}
+}
+
+// newPage starts a new page.
+func (layout *pdfLayout) newPage() {
+	layout.pages = append(layout.pages, &bytes.Buffer{})
+	layout.y = pdfMargin
+}
+
+// page returns the content of the current page.
+func (layout *pdfLayout) page() *bytes.Buffer {
+	return layout.pages[len(layout.pages)-1]
+}
+
+// lineHeight returns the height of a line of text at size.
+func (layout *pdfLayout) lineHeight(size float64) float64 {
+	return size * layout.scale * 1.3
+}
+
+// reserve starts a new page unless height fits on the current page.
+func (layout *pdfLayout) reserve(height float64) {
+	if layout.y+height > pdfPageHeight-pdfMargin && layout.y > pdfMargin {
+		layout.newPage()
+	}
+}
+
+// space leaves a gap of height before the next line.
+func (layout *pdfLayout) space(height float64) {
+	layout.y += height * layout.scale
+}
+
+// text places a single line of text, indented from the left margin.
+func (layout *pdfLayout) text(font *pdfFont, size, indent float64, text string) {
+	height := layout.lineHeight(size)
+	layout.reserve(height)
+	layout.show(font, size, pdfMargin+indent*layout.scale, layout.y+size*layout.scale, encode(text))
+	layout.y += height
+}
+
+// show writes encoded text to the current page with its baseline at y from the top.
+func (layout *pdfLayout) show(font *pdfFont, size, x, y float64, encoded []byte) {
+	fmt.Fprintf(layout.page(), "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n",
+		font.resource, size*layout.scale, x, pdfPageHeight-y, escape(encoded))
+}
+
+// paragraph places text which is wrapped to the width of the page.
+func (layout *pdfLayout) paragraph(font *pdfFont, size, indent float64, text string) {
+	for _, line := range layout.wrap(font, size, pdfPageWidth-2*pdfMargin-indent*layout.scale, text) {
+		layout.text(font, size, indent, line)
+	}
+}
+
+// pdfColor returns a hex color as the red, green and blue operands of a PDF color operator,
+// or fallback if the color is not a hex color.
+func pdfColor(hex, fallback string) string {
+	if !colorPattern.MatchString(hex) {
+		return fallback
+	}
+
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return fallback
+	}
+
+	return fmt.Sprintf("%.2f %.2f %.2f", float64(value>>16&0xff)/255, float64(value>>8&0xff)/255, float64(value&0xff)/255)
+}
+
+// heading places the heading of a section along with a rule, keeping it on the same page
+// as the first line of the section.
+func (layout *pdfLayout) heading(text string) {
+	layout.space(8)
+	layout.reserve(layout.lineHeight(12) + layout.lineHeight(10))
+
+	fmt.Fprintf(layout.page(), "%s rg\n", layout.headingColor)
+	layout.text(helveticaBold, 12, 0, strings.ToUpper(text))
+	fmt.Fprintf(layout.page(), "0 0 0 rg\n")
+
+	fmt.Fprintf(layout.page(), "%s RG 0.75 w %.2f %.2f m %.2f %.2f l S\n", layout.ruleColor,
+		pdfMargin, pdfPageHeight-layout.y+2, pdfPageWidth-pdfMargin, pdfPageHeight-layout.y+2)
+	layout.space(3)
+}
+
+// row places text on the left of a line and detail aligned to the right margin, such as a
+// title and its dates.
+func (layout *pdfLayout) row(font *pdfFont, size float64, text, detail string) {
+	detailSize := size * 0.9
+	encoded := encode(detail)
+	detailWidth := helvetica.width(encoded, detailSize*layout.scale)
+
+	lines := layout.wrap(font, size, pdfPageWidth-2*pdfMargin-detailWidth-12, text)
+	layout.reserve(layout.lineHeight(size))
+
+	if detail != "" {
+		layout.show(helvetica, detailSize, pdfPageWidth-pdfMargin-detailWidth, layout.y+size*layout.scale, encoded)
+	}
+
+	for _, line := range lines {
+		layout.text(font, size, 0, line)
+	}
+}
+
+// labelled places text following a bold label, such as a family of skills.
+func (layout *pdfLayout) labelled(label, text string) {
+	label += ": "
+	labelWidth := helveticaBold.width(encode(label), 10*layout.scale)
+
+	layout.reserve(layout.lineHeight(10))
+	layout.show(helveticaBold, 10, pdfMargin, layout.y+10*layout.scale, encode(label))
+
+	lines := layout.wrap(helvetica, 10, pdfPageWidth-2*pdfMargin-labelWidth, text)
+	for i, line := range lines {
+		if i > 0 {
+			labelWidth = 0
+		}
+
+		layout.text(helvetica, 10, labelWidth/layout.scale, line)
+	}
+
+	if len(lines) == 0 {
+		layout.y += layout.lineHeight(10)
+	}
+}
+
+// bullet places an item of a list.
+func (layout *pdfLayout) bullet(text string) {
+	layout.reserve(layout.lineHeight(10))
+	layout.show(helvetica, 10, pdfMargin+6*layout.scale, layout.y+10*layout.scale, encode("•"))
+	layout.paragraph(helvetica, 10, 16, text)
+}
+
+// wrap breaks text into lines which fit within width when printed in the font at size.
+// A word which is wider than a line is broken between its characters.
+func (layout *pdfLayout) wrap(font *pdfFont, size, width float64, text string) []string {
+	size *= layout.scale
+	lines := []string{}
+	line := ""
+
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+
+		if font.width(encode(candidate), size) <= width {
+			line = candidate
+
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+
+		line = ""
+
+		for _, r := range word {
+			if line != "" && font.width(encode(line+string(r)), size) > width {
+				lines = append(lines, line)
+				line = ""
+			}
+
+			line += string(r)
+		}
+	}
+
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// escape returns encoded text as the content of a PDF string.
+func escape(encoded []byte) []byte {
+	escaped := make([]byte, 0, len(encoded))
+
+	for _, code := range encoded {
+		if code == '\\' || code == '(' || code == ')' {
+			escaped = append(escaped, '\\')
+		}
+
+		escaped = append(escaped, code)
+	}
+
+	return escaped
+}
+
+// document writes the pages as a PDF document.  The document carries no timestamps, so that
+// the same resume always renders to the same bytes.
+func (layout *pdfLayout) document(title string) ([]byte, error) {
+	var document bytes.Buffer
+
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, document.Len())
+		fmt.Fprintf(&document, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	document.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// the catalog, the page tree, the document information and the fonts come first, so
+	// that the objects of each page follow them in order
+	const firstPage = 4
+	pageObject := func(i int) int { return firstPage + len(pdfFonts) + 2*i }
+
+	kids := []string{}
+	for i := range layout.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObject(i)))
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %.0f %.0f] >>",
+		strings.Join(kids, " "), len(layout.pages), pdfPageWidth, pdfPageHeight))
+	object(fmt.Sprintf("<< /Title (%s) /Producer (resume-operator) >>", escape(encode(title))))
+
+	fonts := []string{}
+	for i, font := range pdfFonts {
+		fonts = append(fonts, fmt.Sprintf("/%s %d 0 R", font.resource, firstPage+i))
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font.base))
+	}
+
+	for i, page := range layout.pages {
+		var content bytes.Buffer
+
+		writer := zlib.NewWriter(&content)
+		if _, err := writer.Write(page.Bytes()); err != nil {
+			return nil, fmt.Errorf("unable to compress page %d of pdf, %w", i+1, err)
+		}
+
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("unable to compress page %d of pdf, %w", i+1, err)
+		}
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			strings.Join(fonts, " "), pageObject(i)+1))
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := document.Len()
+
+	fmt.Fprintf(&document, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&document, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&document, "trailer\n<< /Size %d /Root 1 0 R /Info 3 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return document.Bytes(), nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+// pdfFont is one of the standard fonts which every PDF reader provides, so that the fonts
+// do not need to be embedded in the document.
+type pdfFont struct {
+	// resource is the name which the page content refers to the font by.
+	resource string
+
+	// base is the name of the standard font.
+	base string
+
+	// widths are the widths of the printable ASCII characters, starting with the space, in
+	// thousandths of the font size.
+	widths [95]int
+}
+
+var (
+	helvetica = &pdfFont{
+		resource: "F1",
+		base:     "Helvetica",
+		widths: [95]int{
+			278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+			556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+			1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+			667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+			333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+			556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+		},
+	}
+
+	helveticaBold = &pdfFont{
+		resource: "F2",
+		base:     "Helvetica-Bold",
+		widths: [95]int{
+			278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+			556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+			975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+			667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+			333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+			611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+		},
+	}
+
+	pdfFonts = []*pdfFont{helvetica, helveticaBold}
+)
+
+// winAnsi maps the characters outside of ASCII which the standard fonts can print to their
+// code in the WinAnsiEncoding of the fonts.  The Latin-1 characters share their code with
+// Unicode and are not listed.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b, 'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// winAnsiWidths are the widths of the characters outside of ASCII which are common in a
+// resume.  Any other character is measured as wide as a digit.
+var winAnsiWidths = map[byte]int{
+	0x85: 1000, 0x91: 222, 0x92: 222, 0x93: 333, 0x94: 333, 0x95: 350, 0x96: 556, 0x97: 1000,
+}
+
+// encode returns text in the WinAnsiEncoding of the standard fonts.  Characters which the
+// fonts cannot print are replaced with a question mark.
+func encode(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+
+	for _, r := range text {
+		switch code, ok := winAnsi[r]; {
+		case ok:
+			encoded = append(encoded, code)
+		case r == '\t' || r == '\n' || r == '\r':
+			encoded = append(encoded, ' ')
+		case r >= ' ' && r <= '~', r >= 0xa0 && r <= 0xff:
+			encoded = append(encoded, byte(r))
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+
+	return encoded
+}
+
+// width returns the width of encoded text which is printed in the font at size.
+func (font *pdfFont) width(encoded []byte, size float64) float64 {
+	total := 0
+
+	for _, code := range encoded {
+		switch width, ok := winAnsiWidths[code]; {
+		case code >= ' ' && code <= '~':
+			total += font.widths[code-' ']
+		case ok:
+			total += width
+		default:
+			total += 556
+		}
+	}
+
+	return float64(total) * size / 1000
+}
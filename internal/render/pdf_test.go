@@ -0,0 +1,269 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var (
+	startXRefPattern = regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`)
+	pageCountPattern = regexp.MustCompile(`/Type /Pages /Kids \[[^\]]*\] /Count (\d+)`)
+	streamPattern    = regexp.MustCompile(`(?s)^<< /Length (\d+) /Filter /FlateDecode >>\nstream\n(.*)\nendstream$`)
+)
+
+// parsePDF follows the cross-reference table of a PDF document to each of its objects, and
+// returns the inflated content of each page in order.
+func parsePDF(document []byte) []string {
+	match := startXRefPattern.FindSubmatch(document)
+	ExpectWithOffset(1, match).NotTo(BeNil(), "the document ends with startxref")
+
+	xref, err := strconv.Atoi(string(match[1]))
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	table := strings.Split(string(document[xref:]), "\n")
+	ExpectWithOffset(1, table[0]).To(Equal("xref"))
+
+	var first, size int
+	_, err = fmt.Sscanf(table[1], "%d %d", &first, &size)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, first).To(Equal(0))
+	ExpectWithOffset(1, table[2]).To(Equal("0000000000 65535 f "))
+
+	contents := []string{}
+	pageObjects := 0
+
+	for number := 1; number < size; number++ {
+		entry := table[2+number]
+		ExpectWithOffset(1, entry).To(HaveLen(19), "each entry of the table is 20 bytes with its newline")
+		ExpectWithOffset(1, entry).To(HaveSuffix(" 00000 n "))
+
+		offset, err := strconv.Atoi(entry[:10])
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		header := fmt.Sprintf("%d 0 obj\n", number)
+		ExpectWithOffset(1, string(document[offset:])).To(HavePrefix(header), "object %d is at its offset", number)
+
+		body := document[offset+len(header):]
+		body = body[:bytes.Index(body, []byte("\nendobj\n"))]
+
+		if bytes.HasPrefix(body, []byte("<< /Type /Page ")) {
+			pageObjects++
+		}
+
+		if stream := streamPattern.FindSubmatch(body); stream != nil {
+			length, err := strconv.Atoi(string(stream[1]))
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, stream[2]).To(HaveLen(length))
+
+			reader, err := zlib.NewReader(bytes.NewReader(stream[2]))
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			content, err := io.ReadAll(reader)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+			contents = append(contents, string(content))
+		}
+	}
+
+	count := pageCountPattern.FindSubmatch(document)
+	ExpectWithOffset(1, count).NotTo(BeNil())
+	ExpectWithOffset(1, string(count[1])).To(Equal(strconv.Itoa(pageObjects)))
+	ExpectWithOffset(1, contents).To(HaveLen(pageObjects))
+
+	return contents
+}
+
+// longResume returns a resume with the given number of highlights, each of which names its
+// number.
+func longResume(pageCount, highlights int) *Resume {
+	resume := testResume("classic")
+	resume.Profile.Spec.PageCount = pageCount
+
+	items := []resumesv1alpha1.TaggedItem{}
+	for i := 0; i < highlights; i++ {
+		items = append(items, resumesv1alpha1.TaggedItem{Text: fmt.Sprintf("Highlight number %d of the position", i)})
+	}
+
+	resume.JobExperiences[0].Spec.Positions[0].Highlights = items
+
+	return resume
+}
+
+var _ = Describe("PDF", func() {
+	It("renders a resume which fits on a page", func() {
+		document, err := PDF(testResume("classic"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(document).To(HavePrefix("%PDF-1.4\n"))
+
+		pages := parsePDF(document)
+		Expect(pages).To(HaveLen(1))
+		Expect(pages[0]).To(ContainSubstring("/F2 22.00 Tf"))
+		Expect(pages[0]).To(ContainSubstring("(Wrote Kubernetes operators in Go) Tj"))
+
+		_, warnings, err := PDFWithWarnings(testResume("classic"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+
+		again, err := PDF(testResume("classic"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(document))
+	})
+
+	It("lays out a resume over the pages of its page count", func() {
+		resume := longResume(2, 60)
+		Expect(PDFPages(resume)).To(Equal(2))
+
+		document, err := PDF(resume)
+		Expect(err).NotTo(HaveOccurred())
+
+		pages := parsePDF(document)
+		Expect(pages).To(HaveLen(2))
+		Expect(pages[0]).To(ContainSubstring("/F2 22.00 Tf"))
+		Expect(pages[1]).To(ContainSubstring("(Highlight number 59 of the position) Tj"))
+	})
+
+	It("scales a resume down to fit within its page count", func() {
+		// the fewest highlights which do not fit on a page at full scale
+		highlights := 1
+		for PDFPages(longResume(1, highlights)) == 1 {
+			highlights++
+		}
+
+		document, err := PDF(longResume(1, highlights))
+		Expect(err).NotTo(HaveOccurred())
+
+		pages := parsePDF(document)
+		Expect(pages).To(HaveLen(1))
+		Expect(pages[0]).NotTo(ContainSubstring("/F2 22.00 Tf"))
+		Expect(pages[0]).To(ContainSubstring("/F2 20.90 Tf"))
+		Expect(pages[0]).To(ContainSubstring(fmt.Sprintf("(Highlight number %d of the position) Tj", highlights-1)))
+	})
+
+	It("cuts off a resume which does not fit at the smallest scale, with a warning", func() {
+		resume := longResume(1, 200)
+
+		document, warnings, err := PDFWithWarnings(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring("more than its pageCount of 1"))
+
+		pages := parsePDF(document)
+		Expect(pages).To(HaveLen(1))
+		Expect(pages[0]).To(ContainSubstring(fmt.Sprintf("/F2 %.2f Tf", 22*pdfScales[len(pdfScales)-1])))
+		Expect(pages[0]).To(ContainSubstring("(Highlight number 0 of the position) Tj"))
+		Expect(pages[0]).NotTo(ContainSubstring("(Highlight number 199 of the position) Tj"))
+		Expect(pages[0]).NotTo(ContainSubstring("Certified Kubernetes Administrator"))
+
+		resume.Profile.Spec.PageCount = 0
+		defaulted, err := PDF(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsePDF(defaulted)).To(HaveLen(resumesv1beta1.DefaultPageCount))
+	})
+
+	It("prints the text which is not Latin-1 in the encoding of the fonts", func() {
+		resume := testResume("classic")
+		resume.Profile.Spec.PageTitle = "Jürgen (CV)"
+		resume.Profile.Spec.Profile.FirstName = "Jürgen"
+		resume.JobExperiences[0].Spec.Employer = "Café “Zürich” – 東京"
+
+		document, err := PDF(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(document)).To(ContainSubstring("/Title (J\xfcrgen \\(CV\\))"))
+
+		pages := parsePDF(document)
+		Expect(pages[0]).To(ContainSubstring("(J\xfcrgen Doe) Tj"))
+		Expect(pages[0]).To(ContainSubstring("(Caf\xe9 \x93Z\xfcrich\x94 \x96 ??) Tj"))
+	})
+})
+
+var _ = Describe("encode", func() {
+	It("encodes text in the WinAnsiEncoding of the fonts", func() {
+		Expect(encode("Resume")).To(Equal([]byte("Resume")))
+		Expect(encode("Zürich café")).To(Equal([]byte("Z\xfcrich caf\xe9")))
+		Expect(encode("“quoted” – 2020 • €5…")).To(Equal([]byte("\x93quoted\x94 \x96 2020 \x95 \x805\x85")))
+	})
+
+	It("replaces the characters which the fonts cannot print", func() {
+		Expect(encode("東京 Ω")).To(Equal([]byte("?? ?")))
+		Expect(encode("a\tb\nc\rd")).To(Equal([]byte("a b c d")))
+		Expect(encode("\x00\x7f")).To(Equal([]byte("??")))
+	})
+
+	It("escapes the delimiters of a PDF string", func() {
+		Expect(escape(encode(`(a\b)`))).To(Equal([]byte(`\(a\\b\)`)))
+	})
+
+	It("measures the width of encoded text", func() {
+		Expect(helvetica.width(encode("  "), 10)).To(BeNumerically("~", 5.56))
+		Expect(helveticaBold.width(encode("M"), 1000)).To(BeNumerically("~", 833))
+		Expect(helvetica.width(encode("–"), 1000)).To(BeNumerically("~", 556))
+		Expect(helvetica.width(encode("東"), 1000)).To(BeNumerically("~", 556))
+	})
+})
+
+var _ = Describe("wrap", func() {
+	var layout *pdfLayout
+
+	BeforeEach(func() {
+		layout = newPDFLayout(1, resumesv1beta1.ProfileSpecTheme{})
+	})
+
+	It("breaks text into lines which fit within the width", func() {
+		text := "Wrote Kubernetes operators in Go, which reconcile the resumes of a team from their manifests"
+
+		lines := layout.wrap(helvetica, 10, 120, text)
+		Expect(len(lines)).To(BeNumerically(">", 1))
+		Expect(strings.Join(lines, " ")).To(Equal(text))
+
+		for _, line := range lines {
+			Expect(helvetica.width(encode(line), 10)).To(BeNumerically("<=", 120), line)
+		}
+	})
+
+	It("breaks a word which is wider than a line between its characters", func() {
+		word := strings.Repeat("x", 40)
+
+		lines := layout.wrap(helvetica, 10, 50, "a "+word)
+		Expect(lines[0]).To(Equal("a"))
+		Expect(strings.Join(lines[1:], "")).To(Equal(word))
+
+		for _, line := range lines {
+			Expect(helvetica.width(encode(line), 10)).To(BeNumerically("<=", 50), line)
+		}
+	})
+
+	It("wraps at the scale of the layout", func() {
+		text := strings.Repeat("word ", 40)
+		scaled := newPDFLayout(0.7, resumesv1beta1.ProfileSpecTheme{})
+
+		Expect(len(scaled.wrap(helvetica, 10, 200, text))).To(BeNumerically("<", len(layout.wrap(helvetica, 10, 200, text))))
+		Expect(layout.wrap(helvetica, 10, 200, "  ")).To(BeEmpty())
+	})
+})
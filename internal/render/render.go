@@ -0,0 +1,163 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"regexp"
+	"sort"
+	"strings"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+var ErrUnknownTheme = errors.New("unknown theme")
+
+// Resume is the data which a resume is rendered from: a Profile along with the members of
+// its collection.
+type Resume struct {
+	Profile        resumesv1beta1.Profile
+	JobExperiences []resumesv1alpha1.JobExperience
+	Certifications []resumesv1alpha1.Certification
+	Educations     []resumesv1alpha1.Education
+	Projects       []resumesv1alpha1.Project
+}
+
+// HTML renders the resume as a single, self-contained HTML page with the theme which is
+// set on the web settings of the Profile.
+func HTML(resume *Resume) ([]byte, error) {
+	theme := resume.Profile.Spec.Web.Theme
+	if theme == "" {
+		theme = resumesv1beta1.DefaultTheme
+	}
+
+	source, ok := themes[theme]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTheme, theme)
+	}
+
+	page, err := template.New(theme).Funcs(funcs).Parse(layout + source)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse theme %q, %w", theme, err)
+	}
+
+	var pageBuffer bytes.Buffer
+	if err := page.Execute(&pageBuffer, resume.Sorted()); err != nil {
+		return nil, fmt.Errorf("unable to render theme %q, %w", theme, err)
+	}
+
+	return pageBuffer.Bytes(), nil
+}
+
+// Themes returns the names of the themes which a resume may be rendered with.
+func Themes() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Sorted returns a copy of the resume with its members in the order in which they are
+// presented, most recent first.  Members without a date keep their relative order.
+func (resume *Resume) Sorted() *Resume {
+	sorted := *resume
+
+	sorted.JobExperiences = append([]resumesv1alpha1.JobExperience{}, resume.JobExperiences...)
+	sort.SliceStable(sorted.JobExperiences, func(i, j int) bool {
+		return sorted.JobExperiences[j].Spec.StartDate.Before(sorted.JobExperiences[i].Spec.StartDate)
+	})
+
+	sorted.Certifications = append([]resumesv1alpha1.Certification{}, resume.Certifications...)
+	sort.SliceStable(sorted.Certifications, func(i, j int) bool {
+		return sorted.Certifications[j].Spec.EarnedDate.Before(sorted.Certifications[i].Spec.EarnedDate)
+	})
+
+	sorted.Educations = append([]resumesv1alpha1.Education{}, resume.Educations...)
+	sort.SliceStable(sorted.Educations, func(i, j int) bool {
+		return sorted.Educations[j].Spec.StartDate.Before(sorted.Educations[i].Spec.StartDate)
+	})
+
+	return &sorted
+}
+
+// funcs are the functions which are available to the themes.
+var funcs = template.FuncMap{
+	"dateRange": dateRange,
+	"link":      Link,
+	"join":      strings.Join,
+	"texts":     resumesv1beta1.ItemTexts,
+	"color":     color,
+	"font":      font,
+}
+
+var (
+	colorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	fontPattern  = regexp.MustCompile(`^[-A-Za-z0-9 ,'"]+$`)
+)
+
+// color returns a hex color of the theme as a CSS value, or fallback if the color is not a hex
+// color.  The manifests which are rendered locally are not validated by the CRD, so the value
+// is checked before it is trusted as CSS.
+func color(value, fallback string) template.CSS {
+	if !colorPattern.MatchString(value) {
+		value = fallback
+	}
+
+	return template.CSS(value)
+}
+
+// font returns a font family of the theme as a CSS value, or fallback if the font is unset or
+// holds more than font family names.
+func font(value, fallback string) template.CSS {
+	if !fontPattern.MatchString(value) {
+		value = fallback
+	}
+
+	return template.CSS(value)
+}
+
+// dateRange returns the display form of a span of time, omitting the dates which are unset.
+func dateRange(start, end resumesv1alpha1.Date) string {
+	switch {
+	case start.IsZero() && end.IsZero():
+		return ""
+	case start.IsZero():
+		return end.Display()
+	case end.IsZero():
+		return start.Display()
+	default:
+		return start.Display() + " – " + end.Display()
+	}
+}
+
+// Link returns an absolute URL for a link which may have been written without its scheme,
+// such as "github.com/jdoe".  The template escapes any URL with an unsafe scheme.
+func Link(url string) string {
+	if url == "" || strings.Contains(url, "://") || strings.HasPrefix(url, "mailto:") {
+		return url
+	}
+
+	return "https://" + url
+}
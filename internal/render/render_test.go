@@ -0,0 +1,164 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+)
+
+// testResume returns a resume with a position, a certification and the given theme.
+func testResume(theme string) *Resume {
+	return &Resume{
+		Profile: resumesv1beta1.Profile{
+			Spec: resumesv1beta1.ProfileSpec{
+				PageTitle: "Jane Doe",
+				PageCount: 1,
+				Profile: resumesv1beta1.ProfileSpecProfile{
+					FirstName:   "Jane",
+					LastName:    "Doe",
+					Email:       "jane@example.com",
+					GithubURL:   "github.com/jdoe",
+					LinkedinURL: "javascript:alert(1)",
+				},
+				Web: resumesv1beta1.ProfileSpecWeb{Theme: theme},
+			},
+		},
+		JobExperiences: []resumesv1alpha1.JobExperience{
+			{
+				Spec: resumesv1alpha1.JobExperienceSpec{
+					Employer:  "Acme",
+					StartDate: "2020-01",
+					Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+						{Title: "Engineer", Highlights: []resumesv1alpha1.TaggedItem{{Text: "Wrote Kubernetes operators in Go"}}},
+					},
+				},
+			},
+		},
+		Certifications: []resumesv1alpha1.Certification{
+			{Spec: resumesv1alpha1.CertificationSpec{Title: "Certified Kubernetes Administrator", Issuer: "CNCF"}},
+		},
+	}
+}
+
+var _ = Describe("HTML", func() {
+	It("renders each theme", func() {
+		Expect(Themes()).To(Equal([]string{"classic", "compact"}))
+
+		for _, theme := range Themes() {
+			page, err := HTML(testResume(theme))
+			Expect(err).NotTo(HaveOccurred(), theme)
+			Expect(string(page)).To(ContainSubstring("<title>Jane Doe</title>"), theme)
+			Expect(string(page)).To(ContainSubstring("Wrote Kubernetes operators in Go"), theme)
+			Expect(string(page)).To(ContainSubstring("Certified Kubernetes Administrator"), theme)
+		}
+	})
+
+	It("selects the theme of the web settings, or the default theme", func() {
+		classic, err := HTML(testResume("classic"))
+		Expect(err).NotTo(HaveOccurred())
+
+		compact, err := HTML(testResume("compact"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compact).NotTo(Equal(classic))
+		Expect(string(compact)).To(ContainSubstring("Georgia, serif"))
+		Expect(string(classic)).NotTo(ContainSubstring("Georgia, serif"))
+
+		defaulted, err := HTML(testResume(""))
+		Expect(err).NotTo(HaveOccurred())
+
+		expected, err := HTML(testResume(resumesv1beta1.DefaultTheme))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(defaulted).To(Equal(expected))
+
+		_, err = HTML(testResume("unknown"))
+		Expect(err).To(MatchError(ErrUnknownTheme))
+	})
+
+	It("escapes the text of the resume", func() {
+		resume := testResume("classic")
+		resume.Profile.Spec.PageTitle = "</title><script>alert(1)</script>"
+		resume.Profile.Spec.Profile.FirstName = `<img src=x onerror="alert(1)">`
+		resume.JobExperiences[0].Spec.Employer = "Acme & Sons <b>"
+		resume.JobExperiences[0].Spec.Positions[0].Highlights[0].Text = "<script>alert(1)</script>"
+
+		page, err := HTML(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(page)).NotTo(ContainSubstring("<script>"))
+		Expect(string(page)).NotTo(ContainSubstring("<img"))
+		Expect(string(page)).NotTo(ContainSubstring("<b>"))
+		Expect(string(page)).To(ContainSubstring("&lt;script&gt;alert(1)&lt;/script&gt;"))
+		Expect(string(page)).To(ContainSubstring("Acme &amp; Sons &lt;b&gt;"))
+	})
+
+	It("links to addresses without their scheme and escapes unsafe links", func() {
+		page, err := HTML(testResume("classic"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(page)).To(ContainSubstring(`href="https://github.com/jdoe"`))
+		Expect(string(page)).To(ContainSubstring(`href="mailto:jane@example.com"`))
+		Expect(string(page)).NotTo(ContainSubstring(`href="javascript:`))
+	})
+
+	It("sets the colors and fonts of the theme", func() {
+		resume := testResume("classic")
+		resume.Profile.Spec.Theme.Colors.Header = "#123456"
+		resume.Profile.Spec.Theme.Fonts.Body = "Open Sans, sans-serif"
+
+		page, err := HTML(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(page)).To(ContainSubstring("h2 { color: #123456;"))
+		Expect(string(page)).To(ContainSubstring("font: 15px/1.5 Open Sans, sans-serif;"))
+	})
+
+	It("falls back from the colors and fonts which are not safe as CSS", func() {
+		resume := testResume("classic")
+		resume.Profile.Spec.Theme.Colors.Header = "red; } body { background: url(https://example.com/x)"
+		resume.Profile.Spec.Theme.Fonts.Body = "serif; } </style><script>alert(1)</script>"
+
+		page, err := HTML(resume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(page)).NotTo(ContainSubstring("url("))
+		Expect(string(page)).NotTo(ContainSubstring("<script>"))
+		Expect(string(page)).To(ContainSubstring("h2 { color: #3e762a;"))
+		Expect(string(page)).To(ContainSubstring("font: 15px/1.5 'Helvetica Neue', Helvetica, Arial, sans-serif;"))
+	})
+})
+
+var _ = Describe("color and font", func() {
+	It("accepts hex colors only", func() {
+		for _, value := range []string{"#fff", "#4C7535"} {
+			Expect(string(color(value, "#000"))).To(Equal(value))
+		}
+
+		for _, value := range []string{"", "red", "#ffff", "#12345g", "#fff;", "expression(alert(1))"} {
+			Expect(string(color(value, "#000"))).To(Equal("#000"), value)
+		}
+	})
+
+	It("accepts font family names only", func() {
+		for _, value := range []string{"Georgia", "'Helvetica Neue', Helvetica, sans-serif", `"Fira Sans"`} {
+			Expect(string(font(value, "serif"))).To(Equal(value))
+		}
+
+		for _, value := range []string{"", "serif;", "serif}", "url(x)", "a<b", "serif /* comment */"} {
+			Expect(string(font(value, "serif"))).To(Equal("serif"), value)
+		}
+	})
+})
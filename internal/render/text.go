@@ -0,0 +1,227 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Markdown renders the resume as a Markdown document.
+func Markdown(resume *Resume) ([]byte, error) {
+	return renderText("Markdown", markdownTemplate, resume)
+}
+
+// Text renders the resume as plain text.
+func Text(resume *Resume) ([]byte, error) {
+	return renderText("Text", textTemplate, resume)
+}
+
+// renderText renders the resume with a text/template.
+func renderText(name, source string, resume *Resume) ([]byte, error) {
+	document, err := template.New(name).Funcs(template.FuncMap(funcs)).Funcs(textFuncs).Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s template, %w", name, err)
+	}
+
+	var documentBuffer bytes.Buffer
+	if err := document.Execute(&documentBuffer, resume.Sorted()); err != nil {
+		return nil, fmt.Errorf("unable to render %s template, %w", name, err)
+	}
+
+	return documentBuffer.Bytes(), nil
+}
+
+// textFuncs are the functions which are available to the text templates in addition to
+// those available to the themes.
+var textFuncs = template.FuncMap{
+	"contacts":  contacts,
+	"underline": underline,
+}
+
+// contacts returns the contact details of the profile which are set, joined by sep.
+func contacts(resume *Resume, sep string) string {
+	profile := resume.Profile.Spec.Profile
+	set := []string{}
+
+	for _, contact := range []string{profile.PhoneNumber, profile.Email, profile.LinkedinURL, profile.GithubURL, profile.Location} {
+		if contact != "" {
+			set = append(set, contact)
+		}
+	}
+
+	return strings.Join(set, sep)
+}
+
+// underline returns a line of char as long as text.
+func underline(char, text string) string {
+	return strings.Repeat(char, len([]rune(text)))
+}
+
+const markdownTemplate = `{{ with .Profile.Spec.Profile -}}
+# {{ .FirstName }} {{ .LastName }}
+{{- end }}
+
+{{ contacts . " · " }}
+{{- with .Profile.Spec.Profile.Overview }}
+
+## Overview
+
+{{ . }}
+{{- end }}
+{{- with .Profile.Spec.Profile.CoreCompetencies }}
+
+## Core Competencies
+{{ range . }}
+- {{ . }}
+{{- end }}
+{{- end }}
+{{- with .Profile.Spec.Profile.Skills }}
+
+## Skills
+{{ range . }}
+- **{{ .Family }}:** {{ join (texts .Items) ", " }}
+{{- end }}
+{{- end }}
+{{- with .JobExperiences }}
+
+## Experience
+{{- range . }}
+
+### {{ .Spec.Employer }}{{ with .Spec.Location }}, {{ . }}{{ end }}
+{{- with dateRange .Spec.StartDate .Spec.EndDate }}
+
+_{{ . }}_
+{{- end }}
+{{- range .Spec.Positions }}
+
+**{{ .Title }}**{{ with dateRange .StartDate .EndDate }} ({{ . }}){{ end }}
+{{- with .Highlights }}
+{{ range . }}
+- {{ . }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- with .Projects }}
+
+## Projects
+{{- range . }}
+
+### {{ if .Spec.RepoURL }}[{{ .Spec.Title }}]({{ link .Spec.RepoURL }}){{ else }}{{ .Spec.Title }}{{ end }}
+{{- with .Spec.Description }}
+
+{{ . }}
+{{- end }}
+{{- with .Spec.TechStack }}
+
+_{{ join . ", " }}_
+{{- end }}
+{{- end }}
+{{- end }}
+{{- with .Educations }}
+
+## Education
+{{ range . }}
+- **{{ .Spec.Degree }}{{ with .Spec.FieldOfStudy }}, {{ . }}{{ end }}**, {{ .Spec.School }}{{ with dateRange .Spec.StartDate .Spec.EndDate }} ({{ . }}){{ end }}
+{{- end }}
+{{- end }}
+{{- with .Certifications }}
+
+## Certifications
+{{ range . }}
+- {{ if .Spec.ValidationURL }}[{{ .Spec.Title }}]({{ link .Spec.ValidationURL }}){{ else }}{{ .Spec.Title }}{{ end }}
+  {{- with .Spec.Issuer }}, {{ . }}{{ end }}
+  {{- if not .Spec.EarnedDate.IsZero }} ({{ .Spec.EarnedDate.Display }}){{ end }}
+{{- end }}
+{{- end }}
+`
+
+const textTemplate = `{{ with .Profile.Spec.Profile -}}
+{{ .FirstName }} {{ .LastName }}
+{{- end }}
+{{ contacts . " | " }}
+{{- with .Profile.Spec.Profile.Overview }}
+
+OVERVIEW
+{{ underline "-" "OVERVIEW" }}
+{{ . }}
+{{- end }}
+{{- with .Profile.Spec.Profile.CoreCompetencies }}
+
+CORE COMPETENCIES
+{{ underline "-" "CORE COMPETENCIES" }}
+{{- range . }}
+* {{ . }}
+{{- end }}
+{{- end }}
+{{- with .Profile.Spec.Profile.Skills }}
+
+SKILLS
+{{ underline "-" "SKILLS" }}
+{{- range . }}
+{{ .Family }}: {{ join (texts .Items) ", " }}
+{{- end }}
+{{- end }}
+{{- with .JobExperiences }}
+
+EXPERIENCE
+{{ underline "-" "EXPERIENCE" }}
+{{- range . }}
+
+{{ .Spec.Employer }}{{ with .Spec.Location }}, {{ . }}{{ end }}{{ with dateRange .Spec.StartDate .Spec.EndDate }}
+{{ . }}{{ end }}
+{{- range .Spec.Positions }}
+{{ .Title }}{{ with dateRange .StartDate .EndDate }} ({{ . }}){{ end }}
+{{- range .Highlights }}
+  * {{ . }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- with .Projects }}
+
+PROJECTS
+{{ underline "-" "PROJECTS" }}
+{{- range . }}
+
+{{ .Spec.Title }}{{ with .Spec.RepoURL }} ({{ link . }}){{ end }}
+{{- with .Spec.Description }}
+{{ . }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- with .Educations }}
+
+EDUCATION
+{{ underline "-" "EDUCATION" }}
+{{- range . }}
+{{ .Spec.Degree }}{{ with .Spec.FieldOfStudy }}, {{ . }}{{ end }}, {{ .Spec.School }}{{ with dateRange .Spec.StartDate .Spec.EndDate }} ({{ . }}){{ end }}
+{{- end }}
+{{- end }}
+{{- with .Certifications }}
+
+CERTIFICATIONS
+{{ underline "-" "CERTIFICATIONS" }}
+{{- range . }}
+{{ .Spec.Title }}{{ with .Spec.Issuer }}, {{ . }}{{ end }}{{ if not .Spec.EarnedDate.IsZero }} ({{ .Spec.EarnedDate.Display }}){{ end }}
+{{- end }}
+{{- end }}
+`
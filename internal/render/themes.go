@@ -0,0 +1,255 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+// themes are the html/template themes which a resume may be rendered with, by name.  Each
+// theme defines the "style" and "body" templates which the layout is assembled from, and
+// may use the section templates which are shared by all themes.
+var themes = map[string]string{
+	"classic": classicTheme,
+	"compact": compactTheme,
+}
+
+// layout is the page which every theme renders into, along with the sections which are
+// shared by the themes.
+const layout = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{ .Profile.Spec.PageTitle }}</title>
+<style>{{ template "style" . }}</style>
+</head>
+<body>
+{{ template "body" . }}
+</body>
+</html>
+{{- define "name" }}{{ .Profile.Spec.Profile.FirstName }} {{ .Profile.Spec.Profile.LastName }}{{ end }}
+{{- define "contacts" }}
+{{- with .Profile.Spec.Profile }}
+<ul class="contacts">
+  {{- if .PhoneNumber }}
+  <li class="phone">{{ .PhoneNumber }}</li>
+  {{- end }}
+  {{- if .Email }}
+  <li class="email"><a href="mailto:{{ .Email }}">{{ .Email }}</a></li>
+  {{- end }}
+  {{- if .LinkedinURL }}
+  <li class="linkedin"><a href="{{ link .LinkedinURL }}">{{ .LinkedinURL }}</a></li>
+  {{- end }}
+  {{- if .GithubURL }}
+  <li class="github"><a href="{{ link .GithubURL }}">{{ .GithubURL }}</a></li>
+  {{- end }}
+  {{- if .Location }}
+  <li class="location">{{ .Location }}</li>
+  {{- end }}
+</ul>
+{{- end }}
+{{- end }}
+{{- define "download" }}
+<a class="download" href="resume.pdf">Download PDF</a>
+{{- end }}
+{{- define "overview" }}
+{{- with .Profile.Spec.Profile.Overview }}
+<section class="overview">
+  <h2>Overview</h2>
+  <p>{{ . }}</p>
+</section>
+{{- end }}
+{{- end }}
+{{- define "competencies" }}
+{{- with .Profile.Spec.Profile.CoreCompetencies }}
+<section class="competencies">
+  <h2>Core Competencies</h2>
+  <ul>
+    {{- range . }}
+    <li>{{ . }}</li>
+    {{- end }}
+  </ul>
+</section>
+{{- end }}
+{{- end }}
+{{- define "skills" }}
+{{- with .Profile.Spec.Profile.Skills }}
+<section class="skills">
+  <h2>Skills</h2>
+  {{- range . }}
+  <div class="skill-family">
+    <h3>{{ .Family }}</h3>
+    <p>{{ join (texts .Items) ", " }}</p>
+  </div>
+  {{- end }}
+</section>
+{{- end }}
+{{- end }}
+{{- define "experience" }}
+{{- with .JobExperiences }}
+<section class="experience">
+  <h2>Experience</h2>
+  {{- range . }}
+  <article class="employer">
+    <header>
+      <h3>{{ .Spec.Employer }}</h3>
+      <p class="meta">{{ with .Spec.Location }}<span class="location">{{ . }}</span>{{ end }}<span class="dates">{{ dateRange .Spec.StartDate .Spec.EndDate }}</span></p>
+    </header>
+    {{- range .Spec.Positions }}
+    <div class="position">
+      <h4>{{ .Title }}<span class="dates">{{ dateRange .StartDate .EndDate }}</span></h4>
+      {{- with .Highlights }}
+      <ul>
+        {{- range . }}
+        <li>{{ . }}</li>
+        {{- end }}
+      </ul>
+      {{- end }}
+    </div>
+    {{- end }}
+  </article>
+  {{- end }}
+</section>
+{{- end }}
+{{- end }}
+{{- define "education" }}
+{{- with .Educations }}
+<section class="education">
+  <h2>Education</h2>
+  {{- range . }}
+  <article class="school">
+    <h3>{{ .Spec.Degree }}{{ with .Spec.FieldOfStudy }}, {{ . }}{{ end }}</h3>
+    <p class="meta"><span>{{ .Spec.School }}{{ with .Spec.Location }}, {{ . }}{{ end }}</span><span class="dates">{{ dateRange .Spec.StartDate .Spec.EndDate }}</span></p>
+    {{- with .Spec.GPA }}
+    <p>GPA: {{ . }}</p>
+    {{- end }}
+    {{- with .Spec.Honors }}
+    <p>{{ join . ", " }}</p>
+    {{- end }}
+  </article>
+  {{- end }}
+</section>
+{{- end }}
+{{- end }}
+{{- define "certifications" }}
+{{- with .Certifications }}
+<section class="certifications">
+  <h2>Certifications</h2>
+  <ul>
+    {{- range . }}
+    <li>
+      {{- if .Spec.ValidationURL }}<a href="{{ link .Spec.ValidationURL }}">{{ .Spec.Title }}</a>{{ else }}{{ .Spec.Title }}{{ end }}
+      {{- with .Spec.Issuer }} <span class="issuer">{{ . }}</span>{{ end }}
+      {{- if not .Spec.EarnedDate.IsZero }} <span class="dates">{{ .Spec.EarnedDate.Display }}</span>{{ end }}
+    </li>
+    {{- end }}
+  </ul>
+</section>
+{{- end }}
+{{- end }}
+{{- define "projects" }}
+{{- with .Projects }}
+<section class="projects">
+  <h2>Projects</h2>
+  {{- range . }}
+  <article class="project">
+    <h3>{{ if .Spec.RepoURL }}<a href="{{ link .Spec.RepoURL }}">{{ .Spec.Title }}</a>{{ else }}{{ .Spec.Title }}{{ end }}{{ with .Spec.Role }} <span class="role">{{ . }}</span>{{ end }}</h3>
+    {{- with .Spec.Description }}
+    <p>{{ . }}</p>
+    {{- end }}
+    {{- with .Spec.TechStack }}
+    <p class="stack">{{ join . ", " }}</p>
+    {{- end }}
+  </article>
+  {{- end }}
+</section>
+{{- end }}
+{{- end }}
+`
+
+// classicTheme renders the resume in two columns, with the contact details and skills of the
+// profile beside the experience, in the colors and fonts of the theme of the Profile.
+const classicTheme = `
+{{- define "style" }}
+{{- $colors := .Profile.Spec.Theme.ResolvedColors }}
+{{- $fonts := .Profile.Spec.Theme.Fonts }}
+body { margin: 0; background: {{ color $colors.PageBackground "#ddd" }}; color: {{ color $colors.Dark "#666" }}; font: 15px/1.5 {{ font $fonts.Body "'Helvetica Neue', Helvetica, Arial, sans-serif" }}; }
+a { color: {{ color $colors.Header2 "#33779d" }}; text-decoration: none; }
+h1, h2, h3, h4 { margin: 0 0 .3em; font-family: {{ font $fonts.Heading "inherit" }}; }
+h2 { color: {{ color $colors.Header "#3e762a" }}; border-bottom: 2px solid {{ color $colors.IconBackground "#96b986" }}; }
+ul { padding-left: 1.2em; }
+.page { display: flex; max-width: 1100px; margin: 2em auto; background: {{ color $colors.Light "#fff" }}; box-shadow: 0 0 8px rgba(0, 0, 0, .2); }
+.main { flex: 2; padding: 2em; }
+.aside { flex: 1; padding: 2em; background: {{ color $colors.RightColumnBackground "#f5f5f5" }}; color: {{ color $colors.RightColumnBodyText "#666" }}; }
+.aside h2 { color: {{ color $colors.RightColumnHeadingText "#4c7535" }}; }
+.contacts { list-style: none; padding: 0; }
+.meta, h4 { display: flex; justify-content: space-between; }
+.dates, .issuer, .role { color: #999; font-weight: normal; margin-left: 1em; }
+article { margin-bottom: 1.5em; }
+.download { display: inline-block; padding: .3em .8em; color: {{ color $colors.IconPrimary "#fff" }}; background: {{ color $colors.Primary "#4c7535" }}; border-radius: 3px; }
+@media print { body { background: #fff; } .page { margin: 0; box-shadow: none; } .download { display: none; } }
+{{ end }}
+{{- define "body" }}
+<div class="page">
+  <aside class="aside">
+    <h1>{{ template "name" . }}</h1>
+    {{- template "contacts" . }}
+    {{- template "download" . }}
+    {{- template "competencies" . }}
+    {{- template "skills" . }}
+    {{- template "certifications" . }}
+  </aside>
+  <main class="main">
+    {{- template "overview" . }}
+    {{- template "experience" . }}
+    {{- template "projects" . }}
+    {{- template "education" . }}
+  </main>
+</div>
+{{- end }}
+`
+
+// compactTheme renders the resume in a single column, which suits printing and plain reading.
+// It keeps its own colors, and takes only the fonts of the theme of the Profile.
+const compactTheme = `
+{{- define "style" }}
+{{- $fonts := .Profile.Spec.Theme.Fonts }}
+body { max-width: 800px; margin: 2em auto; padding: 0 1em; color: #222; font: 14px/1.4 {{ font $fonts.Body "Georgia, serif" }}; }
+a { color: inherit; }
+h1, h2, h3, h4 { font-family: {{ font $fonts.Heading "inherit" }}; }
+h1 { margin-bottom: 0; }
+h2 { margin: 1.2em 0 .4em; font-size: 1.1em; text-transform: uppercase; letter-spacing: .1em; border-bottom: 1px solid #222; }
+h3, h4 { margin: .4em 0 .2em; }
+ul { margin: .2em 0; }
+.contacts { list-style: none; padding: 0; }
+.contacts li { display: inline; margin-right: 1em; }
+.meta, h4 { display: flex; justify-content: space-between; }
+.dates, .issuer, .role { font-style: italic; font-weight: normal; margin-left: 1em; }
+@media print { .download { display: none; } }
+{{ end }}
+{{- define "body" }}
+<header>
+  <h1>{{ template "name" . }}</h1>
+  {{- template "contacts" . }}
+  {{- template "download" . }}
+</header>
+{{- template "overview" . }}
+{{- template "competencies" . }}
+{{- template "experience" . }}
+{{- template "projects" . }}
+{{- template "education" . }}
+{{- template "certifications" . }}
+{{- template "skills" . }}
+{{- end }}
+`
@@ -0,0 +1,353 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tailor matches a resume against a job description by the keywords which they
+// share.  The matching is a deterministic analysis of the text alone, so that the same job
+// description always tailors a resume the same way.
+package tailor
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+// Kinds of the items of a resume which are scored.
+const (
+	KindHighlight     = "highlight"
+	KindSkill         = "skill"
+	KindCertification = "certification"
+)
+
+// Keyword is a keyword of a job description, with the number of times that it occurs.  The
+// term is the keyword as it is first written in the job description.
+type Keyword struct {
+	Term  string
+	Count int
+
+	// stem is the form of the term which is matched.
+	stem string
+}
+
+// Item is an item of a resume, scored by the keywords of a job description which it shares.
+type Item struct {
+	Kind string
+
+	// Source is where the item is found in the resume, e.g. the employer and title of the
+	// position of a highlight.
+	Source string
+	Text   string
+
+	// Score is the sum of the counts of the keywords which the item matches, so that an item
+	// which matches the keywords that the job description repeats scores higher.
+	Score   int
+	Matches []string
+
+	// Position is the index of the position of a highlight among all positions of the
+	// resume, so that the highlights of a position may be kept together.
+	Position int
+}
+
+// Report is the match of a resume against a job description.
+type Report struct {
+	// Keywords are the keywords of the job description, the most frequent first.
+	Keywords []Keyword
+
+	// Highlights, Skills and Certifications are the items of the resume, the best matching
+	// first.
+	Highlights     []Item
+	Skills         []Item
+	Certifications []Item
+
+	// Gaps are the keywords of the job description which no item of the resume matches, the
+	// most frequent first.
+	Gaps []Keyword
+}
+
+// Match scores the highlights, skills and certifications of a resume against the keywords of
+// a job description.  Highlights and skills which are hidden are not scored, nor are those
+// which are left out for the audience of the profile when the resume is tailored to it.
+func Match(resume *render.Resume, jobDescription string) *Report {
+	keywords := Keywords(jobDescription)
+
+	byStem := map[string]Keyword{}
+	for _, keyword := range keywords {
+		byStem[keyword.stem] = keyword
+	}
+
+	report := &Report{Keywords: keywords}
+	matched := map[string]bool{}
+
+	score := func(kind, source, text string, position int) Item {
+		item := Item{Kind: kind, Source: source, Text: text, Position: position}
+
+		for _, term := range terms(text) {
+			if keyword, ok := byStem[term.stem]; ok && !containsMatch(item.Matches, keyword.Term) {
+				item.Score += keyword.Count
+				item.Matches = append(item.Matches, keyword.Term)
+				matched[keyword.stem] = true
+			}
+		}
+
+		return item
+	}
+
+	sorted := resume.Sorted()
+	position := 0
+
+	for _, jobExperience := range sorted.JobExperiences {
+		for _, jobPosition := range jobExperience.Spec.Positions {
+			source := strings.TrimSpace(jobExperience.Spec.Employer + " / " + jobPosition.Title)
+
+			for _, highlight := range jobPosition.Highlights {
+				if highlight.Hidden {
+					continue
+				}
+
+				report.Highlights = append(report.Highlights, score(KindHighlight, source, highlight.Text, position))
+			}
+
+			position++
+		}
+	}
+
+	for _, family := range sorted.Profile.Spec.Profile.Skills {
+		for _, skill := range family.Items {
+			if skill.Hidden {
+				continue
+			}
+
+			report.Skills = append(report.Skills, score(KindSkill, family.Family, skill.Text, 0))
+		}
+	}
+
+	for _, certification := range sorted.Certifications {
+		text := strings.Join([]string{certification.Spec.Title, certification.Spec.Alias}, " ")
+		report.Certifications = append(report.Certifications, score(KindCertification, certification.Spec.Issuer, text, 0))
+	}
+
+	for _, items := range [][]Item{report.Highlights, report.Skills, report.Certifications} {
+		sortItems(items)
+	}
+
+	for _, keyword := range keywords {
+		if !matched[keyword.stem] {
+			report.Gaps = append(report.Gaps, keyword)
+		}
+	}
+
+	return report
+}
+
+// Keep returns the highlights which are kept when the resume holds at most budget of them.
+// The best match of each position is kept first, so that no position is left without a
+// highlight, and the rest are kept by their score while the budget allows.  A budget which is
+// less than one keeps every highlight.
+func (report *Report) Keep(budget int) []Item {
+	if budget < 1 || budget >= len(report.Highlights) {
+		return append([]Item{}, report.Highlights...)
+	}
+
+	kept := []Item{}
+	keptIndexes := map[int]bool{}
+	positions := map[int]bool{}
+
+	for i, highlight := range report.Highlights {
+		if len(kept) < budget && !positions[highlight.Position] {
+			kept = append(kept, highlight)
+			keptIndexes[i] = true
+			positions[highlight.Position] = true
+		}
+	}
+
+	for i, highlight := range report.Highlights {
+		if len(kept) < budget && !keptIndexes[i] {
+			kept = append(kept, highlight)
+		}
+	}
+
+	sortItems(kept)
+
+	return kept
+}
+
+// Fit returns the largest budget of highlights for which fits reports that the resume fits,
+// trying each budget from all of the highlights down.  A budget of one is returned when none
+// of the larger budgets fits.
+func (report *Report) Fit(fits func(budget int) bool) int {
+	for budget := len(report.Highlights); budget > 1; budget-- {
+		if fits(budget) {
+			return budget
+		}
+	}
+
+	return 1
+}
+
+// Excluded returns the text of the highlights which are not kept when the resume holds at
+// most budget of them, by position in the order of the resume.  Highlights are excluded from
+// a ResumeVariant by their text, so a highlight which is kept in any position is not
+// excluded.
+func (report *Report) Excluded(budget int) []string {
+	kept := map[string]bool{}
+	for _, highlight := range report.Keep(budget) {
+		kept[strings.ToLower(highlight.Text)] = true
+	}
+
+	excluded := []Item{}
+
+	for _, highlight := range report.Highlights {
+		if !kept[strings.ToLower(highlight.Text)] {
+			excluded = append(excluded, highlight)
+		}
+	}
+
+	sort.SliceStable(excluded, func(i, j int) bool {
+		return excluded[i].Position < excluded[j].Position
+	})
+
+	texts := make([]string, 0, len(excluded))
+	for _, highlight := range excluded {
+		texts = append(texts, highlight.Text)
+	}
+
+	return texts
+}
+
+// Keywords returns the keywords of a job description, the most frequent first and then in
+// alphabetical order.  Words which are common to any text, or to any job description, are
+// not keywords.
+func Keywords(jobDescription string) []Keyword {
+	keywords := []Keyword{}
+	indexes := map[string]int{}
+
+	for _, term := range terms(jobDescription) {
+		if i, ok := indexes[term.stem]; ok {
+			keywords[i].Count++
+
+			continue
+		}
+
+		indexes[term.stem] = len(keywords)
+		keywords = append(keywords, Keyword{Term: term.word, Count: 1, stem: term.stem})
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Count != keywords[j].Count {
+			return keywords[i].Count > keywords[j].Count
+		}
+
+		return keywords[i].Term < keywords[j].Term
+	})
+
+	return keywords
+}
+
+// term is a word of a text, along with its stem, which is the form of the word that is
+// matched.
+type term struct {
+	word string
+	stem string
+}
+
+// terms splits text into the terms which are matched, in the order that they occur.  Terms
+// are lower case words, which may hold the symbols of names such as "c++", "c#" and
+// "node.js", and are stemmed by reducing plurals to their singular form.  Stop words, single
+// characters and numbers are not terms.
+func terms(text string) []term {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("+#.", r)
+	})
+
+	result := []term{}
+
+	for _, word := range words {
+		word = strings.Trim(word, ".")
+		if len([]rune(word)) < 2 || stopWords[word] || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+
+		if stem := singular(word); !stopWords[stem] {
+			result = append(result, term{word: word, stem: stem})
+		}
+	}
+
+	return result
+}
+
+// singular reduces the regular plural form of a word to its singular form.
+func singular(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 3 && strings.HasSuffix(word, "s") &&
+		!strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
+
+// sortItems sorts items by their score, keeping the order of the resume for equal scores.
+func sortItems(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Score > items[j].Score
+	})
+}
+
+// containsMatch determines if a list of matched keywords contains a keyword.
+func containsMatch(matches []string, match string) bool {
+	for _, m := range matches {
+		if m == match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stopWords are the words which are common to any text, or to any job description, and so
+// say nothing of the role.
+var stopWords = setOf(
+	"a", "about", "above", "across", "after", "all", "also", "an", "and", "any", "are", "as",
+	"at", "be", "been", "being", "both", "but", "by", "can", "could", "do", "does", "each",
+	"either", "etc", "every", "for", "from", "had", "has", "have", "he", "her", "his", "how",
+	"if", "in", "into", "is", "it", "its", "may", "more", "most", "much", "must", "no", "not",
+	"of", "on", "one", "or", "other", "our", "ours", "out", "over", "own", "per", "plus",
+	"should", "so", "some", "such", "than", "that", "the", "their", "them", "then", "there",
+	"these", "they", "this", "those", "through", "to", "too", "under", "up", "upon", "us",
+	"very", "was", "we", "well", "were", "what", "when", "where", "which", "while", "who",
+	"whom", "why", "will", "with", "within", "without", "would", "you", "your", "yours",
+
+	// words of any job description
+	"ability", "able", "applicant", "apply", "bonus", "candidate", "company", "day",
+	"environment", "equal", "excellent", "experience", "good", "great", "help", "ideal",
+	"including", "job", "join", "looking", "new", "opportunity", "position",
+	"preferred", "qualification", "required", "requirement", "responsibility", "role",
+	"skill", "strong", "team", "work", "working", "year",
+)
+
+// setOf returns a set of words.
+func setOf(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return set
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tailor
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/internal/render"
+)
+
+const jobDescription = `Senior Platform Engineer
+
+We are looking for an engineer to build our Kubernetes platform.  You will write
+Kubernetes operators in Go, and manage our clusters with Terraform.
+
+Requirements: 5+ years of experience with Kubernetes and CI/CD.`
+
+// testResume returns a resume with two positions, skills and a certification.
+func testResume() *render.Resume {
+	jobExperience := func(employer, start string, highlights ...resumesv1alpha1.TaggedItem) resumesv1alpha1.JobExperience {
+		return resumesv1alpha1.JobExperience{
+			Spec: resumesv1alpha1.JobExperienceSpec{
+				Employer:  employer,
+				StartDate: resumesv1alpha1.Date(start),
+				Positions: []resumesv1alpha1.JobExperienceSpecPosition{
+					{Title: "Engineer", Highlights: highlights},
+				},
+			},
+		}
+	}
+
+	return &render.Resume{
+		Profile: resumesv1beta1.Profile{
+			Spec: resumesv1beta1.ProfileSpec{
+				Profile: resumesv1beta1.ProfileSpecProfile{
+					Skills: []resumesv1beta1.ProfileSpecSkillFamily{
+						{Family: "Languages", Items: resumesv1beta1.TextItems("Go", "Python")},
+						{Family: "Platforms", Items: resumesv1beta1.TextItems("Kubernetes")},
+					},
+				},
+			},
+		},
+		JobExperiences: []resumesv1alpha1.JobExperience{
+			jobExperience("Initech", "2015-01",
+				resumesv1alpha1.TaggedItem{Text: "Kept the lights on"},
+				resumesv1alpha1.TaggedItem{Text: "Ran the Kubernetes clusters"},
+			),
+			jobExperience("Acme", "2020-01",
+				resumesv1alpha1.TaggedItem{Text: "Wrote Kubernetes operators in Go"},
+				resumesv1alpha1.TaggedItem{Text: "Planned the holiday party"},
+				resumesv1alpha1.TaggedItem{Text: "Hidden platform work", Hidden: true},
+			),
+		},
+		Certifications: []resumesv1alpha1.Certification{
+			{Spec: resumesv1alpha1.CertificationSpec{Title: "Certified Kubernetes Administrator", Issuer: "CNCF", Alias: "CKA"}},
+		},
+	}
+}
+
+var _ = Describe("Keywords", func() {
+	It("counts the keywords of a job description without stop words", func() {
+		keywords := Keywords(jobDescription)
+
+		Expect(keywords[0]).To(Equal(Keyword{Term: "kubernetes", Count: 3, stem: "kubernete"}))
+		Expect(keywords).To(ContainElement(Keyword{Term: "operators", Count: 1, stem: "operator"}))
+		Expect(keywords).To(ContainElement(Keyword{Term: "go", Count: 1, stem: "go"}))
+		Expect(keywords).To(ContainElement(Keyword{Term: "ci", Count: 1, stem: "ci"}))
+
+		for _, keyword := range keywords {
+			Expect(stopWords).NotTo(HaveKey(keyword.stem))
+		}
+	})
+
+	It("is deterministic", func() {
+		Expect(Keywords(jobDescription)).To(Equal(Keywords(jobDescription)))
+	})
+})
+
+var _ = Describe("Match", func() {
+	var report *Report
+
+	BeforeEach(func() {
+		report = Match(testResume(), jobDescription)
+	})
+
+	It("scores the highlights by the keywords which they match, most recent position first", func() {
+		texts := []string{}
+		for _, highlight := range report.Highlights {
+			texts = append(texts, highlight.Text)
+		}
+
+		Expect(texts).To(Equal([]string{
+			"Wrote Kubernetes operators in Go",
+			"Ran the Kubernetes clusters",
+			"Planned the holiday party",
+			"Kept the lights on",
+		}))
+		Expect(report.Highlights[0].Score).To(Equal(5))
+		Expect(report.Highlights[0].Matches).To(Equal([]string{"kubernetes", "operators", "go"}))
+		Expect(report.Highlights[0].Source).To(Equal("Acme / Engineer"))
+		Expect(report.Highlights[1].Matches).To(Equal([]string{"kubernetes", "clusters"}))
+	})
+
+	It("scores the skills and certifications", func() {
+		Expect(report.Skills[0].Text).To(Equal("Kubernetes"))
+		Expect(report.Skills[1].Text).To(Equal("Go"))
+		Expect(report.Skills[2].Score).To(BeZero())
+		Expect(report.Certifications[0].Matches).To(Equal([]string{"kubernetes"}))
+	})
+
+	It("reports the keywords which no item matches as gaps", func() {
+		gaps := []string{}
+		for _, gap := range report.Gaps {
+			gaps = append(gaps, gap.Term)
+		}
+
+		Expect(gaps).To(ContainElements("terraform", "ci", "cd", "platform"))
+		Expect(gaps).NotTo(ContainElements("kubernetes", "go", "operators"))
+	})
+
+	It("keeps the best match of each position within the budget", func() {
+		kept := report.Keep(2)
+		Expect(kept).To(HaveLen(2))
+		Expect(kept[0].Text).To(Equal("Wrote Kubernetes operators in Go"))
+		Expect(kept[1].Text).To(Equal("Ran the Kubernetes clusters"))
+
+		Expect(report.Excluded(2)).To(Equal([]string{"Planned the holiday party", "Kept the lights on"}))
+		Expect(report.Excluded(1)).To(Equal([]string{"Planned the holiday party", "Ran the Kubernetes clusters", "Kept the lights on"}))
+		Expect(report.Excluded(len(report.Highlights))).To(BeEmpty())
+	})
+
+	It("fits the largest budget which the resume fits within", func() {
+		tried := []int{}
+		budget := report.Fit(func(budget int) bool {
+			tried = append(tried, budget)
+
+			return budget <= 2
+		})
+
+		Expect(budget).To(Equal(2))
+		Expect(tried).To(Equal([]int{4, 3, 2}))
+		Expect(report.Fit(func(int) bool { return false })).To(Equal(1))
+	})
+
+	It("fits a budget by the pages of the PDF of the resume", func() {
+		resume := testResume()
+		resume.Profile.Spec.PageCount = 1
+
+		many := []resumesv1alpha1.TaggedItem{}
+		for i := 0; i < 120; i++ {
+			many = append(many, resumesv1alpha1.TaggedItem{Text: fmt.Sprintf("Ran Kubernetes cluster number %d for the platform team", i)})
+		}
+
+		resume.JobExperiences[0].Spec.Positions[0].Highlights = many
+		Expect(render.PDFPages(resume)).To(BeNumerically(">", 1))
+
+		report := Match(resume, jobDescription)
+		budget := report.Fit(func(budget int) bool {
+			kept := map[string]bool{}
+			for _, highlight := range report.Keep(budget) {
+				kept[highlight.Text] = true
+			}
+
+			fitted := testResume()
+			fitted.Profile.Spec.PageCount = 1
+			fitted.JobExperiences[0].Spec.Positions[0].Highlights = nil
+
+			for _, highlight := range many {
+				if kept[highlight.Text] {
+					fitted.JobExperiences[0].Spec.Positions[0].Highlights = append(fitted.JobExperiences[0].Spec.Positions[0].Highlights, highlight)
+				}
+			}
+
+			return render.PDFPages(fitted) <= 1
+		})
+
+		Expect(budget).To(BeNumerically(">", 1))
+		Expect(budget).To(BeNumerically("<", len(report.Highlights)))
+	})
+})
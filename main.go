@@ -18,6 +18,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -33,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
 	resumescontrollers "github.com/jefedavis/resume-operator/controllers/resumes"
 	//+kubebuilder:scaffold:imports
 )
@@ -42,6 +44,10 @@ type ReconcilerInitializer interface {
 	SetupWithManager(ctrl.Manager) error
 }
 
+type WebhookInitializer interface {
+	SetupWebhookWithManager(ctrl.Manager) error
+}
+
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -51,6 +57,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(resumesv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(resumesv1beta1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -99,6 +106,9 @@ func main() {
 		resumescontrollers.NewProfileReconciler(mgr),
 		resumescontrollers.NewJobExperienceReconciler(mgr),
 		resumescontrollers.NewCertificationReconciler(mgr),
+		resumescontrollers.NewEducationReconciler(mgr),
+		resumescontrollers.NewProjectReconciler(mgr),
+		resumescontrollers.NewResumeVariantReconciler(mgr),
 		//+kubebuilder:scaffold:reconcilers
 	}
 
@@ -109,6 +119,24 @@ func main() {
 		}
 	}
 
+	// webhooks may be disabled when running the manager outside of the cluster, where no
+	// serving certificates are available
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		webhooks := []WebhookInitializer{
+			&resumesv1beta1.Profile{},
+			&resumesv1alpha1.JobExperience{},
+			&resumesv1alpha1.Certification{},
+			&resumesv1alpha1.ResumeVariant{},
+		}
+
+		for _, webhook := range webhooks {
+			if err = webhook.SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", fmt.Sprintf("%T", webhook))
+				os.Exit(1)
+			}
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -28,7 +28,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	resumesv1alpha1 "github.com/jefedavis/resume-operator/apis/resumes/v1alpha1"
-	"github.com/jefedavis/resume-operator/apis/resumes/v1alpha1/resume"
+	resumesv1beta1 "github.com/jefedavis/resume-operator/apis/resumes/v1beta1"
+	"github.com/jefedavis/resume-operator/apis/resumes/v1beta1/resume"
 )
 
 //
@@ -40,12 +41,20 @@ func resumesv1alpha1ProfileChildrenFuncs(tester *E2ETest) error {
 		return nil
 	}
 
-	workload, err := resume.ConvertWorkload(tester.workload)
-	if err != nil {
+	// the controller reconciles the v1beta1 version of the workload
+	alpha, ok := tester.workload.(*resumesv1alpha1.Profile)
+	if !ok {
+		return fmt.Errorf("error in workload conversion; %w", resumesv1alpha1.ErrUnableToConvertProfile)
+	}
+
+	var workload resumesv1beta1.Profile
+	if err := alpha.ConvertTo(&workload); err != nil {
 		return fmt.Errorf("error in workload conversion; %w", err)
 	}
 
-	resourceObjects, err := resume.Generate(*workload)
+	workload.Default()
+
+	resourceObjects, err := resume.Generate(workload, resume.Members{})
 	if err != nil {
 		return fmt.Errorf("unable to create objects in memory; %w", err)
 	}